@@ -52,3 +52,88 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRRequestBodiesMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		contentType  string
+		body         string
+		cassetteBody string
+		want         bool
+	}{
+		{
+			name:         "identical",
+			contentType:  "application/octet-stream",
+			body:         "abc",
+			cassetteBody: "abc",
+			want:         true,
+		},
+		{
+			name:         "different",
+			contentType:  "application/octet-stream",
+			body:         "abc",
+			cassetteBody: "abd",
+		},
+		{
+			name:         "JSON reordered",
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"Name":"test","Tags":[{"Key":"k","Value":"v"}]}`,
+			cassetteBody: `{"Tags":[{"Value":"v","Key":"k"}],"Name":"test"}`,
+			want:         true,
+		},
+		{
+			name:         "JSON idempotency token",
+			contentType:  "application/json",
+			body:         `{"Name":"test","ClientToken":"a9d7c0bd-3d4c-4d26-9b1c-3c8f1e0e1b2a"}`,
+			cassetteBody: `{"ClientToken":"0e6d4f4c-4a0f-4d3f-8d6f-2f5c7f8b3c1d","Name":"test"}`,
+			want:         true,
+		},
+		{
+			name:         "JSON different",
+			contentType:  "application/x-amz-json-1.0",
+			body:         `{"Name":"test1"}`,
+			cassetteBody: `{"Name":"test2"}`,
+		},
+		{
+			name:         "XML formatted",
+			contentType:  "application/xml",
+			body:         `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><Name>example.com</Name><CallerReference>1</CallerReference></CreateHostedZoneRequest>`,
+			cassetteBody: "<CreateHostedZoneRequest xmlns=\"https://route53.amazonaws.com/doc/2013-04-01/\">\n  <Name>example.com</Name>\n  <CallerReference>2</CallerReference>\n</CreateHostedZoneRequest>",
+			want:         true,
+		},
+		{
+			name:         "XML different",
+			contentType:  "text/xml; charset=utf-8",
+			body:         `<Request><Name>a</Name></Request>`,
+			cassetteBody: `<Request><Name>b</Name></Request>`,
+		},
+		{
+			name:         "query reordered",
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=RunInstances&ClientToken=abc&ImageId=ami-12345678&Version=2016-11-15",
+			cassetteBody: "Action=RunInstances&Version=2016-11-15&ImageId=ami-12345678&ClientToken=def",
+			want:         true,
+		},
+		{
+			name:         "query different",
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=RunInstances&ImageId=ami-12345678",
+			cassetteBody: "Action=RunInstances&ImageId=ami-87654321",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			if got, want := acctest.VCRRequestBodiesMatch(ctx, testCase.contentType, testCase.body, testCase.cassetteBody), testCase.want; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
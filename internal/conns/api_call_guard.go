@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// APICallGuard is called before each AWS API call is made.
+// Returning an error prevents the call from being made and the error is returned to the caller.
+type APICallGuard func(ctx context.Context, service, operation string) error
+
+// middleware returns the guard as AWS SDK for Go v2 middleware.
+func (g APICallGuard) middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("APICallGuard", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if err := g(ctx, awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetOperationName(ctx)); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.After)
+	}
+}
+
+// withAPICallGuard adds the guard's middleware to the specified AWS SDK for Go v2 configuration.
+func (g APICallGuard) withAPICallGuard(cfg *aws_sdkv2.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, g.middleware())
+}
+
+// withAPICallGuardSDKv1 adds the guard's handler to the specified AWS SDK for Go v1 session.
+// The handler is run before the request is validated, so a prevented call is never sent.
+func (g APICallGuard) withAPICallGuardSDKv1(sess *session_sdkv1.Session) {
+	sess.Handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tf-aws.APICallGuard",
+		Fn: func(r *request_sdkv1.Request) {
+			if err := g(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		},
+	})
+}
@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	awserr_sdkv1 "github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	// AuditLogPathEnvVar is the environment variable that sets the audit log file path.
+	AuditLogPathEnvVar = "TF_AWS_AUDIT_LOG_PATH"
+
+	auditLogRedacted = "REDACTED"
+)
+
+// AuditLogConfig contains the configuration for the AWS API call audit log.
+type AuditLogConfig struct {
+	// IncludeParameters includes API call parameters, with any secrets redacted, in each record.
+	IncludeParameters bool
+	// Path is the path of the JSON Lines file that records are appended to.
+	Path string
+}
+
+// auditLogRecord is a single audit log record.
+type auditLogRecord struct {
+	Time         time.Time `json:"time"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	Phase        string    `json:"phase,omitempty"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	HTTPStatus   int       `json:"http_status,omitempty"`
+	DurationMS   int64     `json:"duration_ms"`
+	RetryCount   int       `json:"retry_count"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+	Parameters   any       `json:"parameters,omitempty"`
+}
+
+// auditLogFile is an audit log file shared by all provider configurations that write to the same path.
+type auditLogFile struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// auditLogger appends a provider configuration's audit log records to a file.
+type auditLogger struct {
+	file              *auditLogFile
+	includeParameters bool
+}
+
+var (
+	auditLogFilesLock sync.Mutex
+	auditLogFiles     = make(map[string]*auditLogFile) // Keyed by file path, shared by all provider instances.
+)
+
+// newAuditLogger returns the audit logger for the specified configuration.
+func newAuditLogger(config *AuditLogConfig) (*auditLogger, error) {
+	auditLogFilesLock.Lock()
+	defer auditLogFilesLock.Unlock()
+
+	file, ok := auditLogFiles[config.Path]
+	if !ok {
+		f, err := os.OpenFile(config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("opening audit log file (%s): %w", config.Path, err)
+		}
+
+		file = &auditLogFile{
+			w: f,
+		}
+		auditLogFiles[config.Path] = file
+	}
+
+	logger := &auditLogger{
+		file:              file,
+		includeParameters: config.IncludeParameters,
+	}
+
+	return logger, nil
+}
+
+// CloseAuditLogs closes all open audit log files.
+// It is called when the provider shuts down. Any records written afterwards are discarded.
+func CloseAuditLogs() error {
+	auditLogFilesLock.Lock()
+	defer auditLogFilesLock.Unlock()
+
+	var errs []error
+
+	for path, file := range auditLogFiles {
+		file.lock.Lock()
+		if v, ok := file.w.(io.Closer); ok {
+			if err := v.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("closing audit log file (%s): %w", path, err))
+			}
+		}
+		file.w = io.Discard
+		file.lock.Unlock()
+
+		delete(auditLogFiles, path)
+	}
+
+	return errors.Join(errs...)
+}
+
+// write appends a record, adding any resource information from Context.
+func (l *auditLogger) write(ctx context.Context, record *auditLogRecord) {
+	if v, ok := FromContext(ctx); ok {
+		record.ResourceType = v.TypeName
+		record.ResourceID = v.ResourceID
+		record.Phase = v.Phase
+	}
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	b = append(b, '\n')
+
+	l.file.lock.Lock()
+	defer l.file.lock.Unlock()
+
+	l.file.w.Write(b) //nolint:errcheck // Audit logging is best-effort.
+}
+
+// parameters returns the redacted API call parameters, or nil if parameters are not included.
+func (l *auditLogger) parameters(v any) any {
+	if !l.includeParameters || v == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var params any
+	if err := json.Unmarshal(b, &params); err != nil {
+		return nil
+	}
+
+	return redactAuditLogParameters(params)
+}
+
+// redactAuditLogParameters replaces the values of any parameters that may contain secrets or payloads.
+func redactAuditLogParameters(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isSensitiveAuditLogParameter(k) {
+				v[k] = auditLogRedacted
+			} else {
+				v[k] = redactAuditLogParameters(e)
+			}
+		}
+		return v
+	case []any:
+		for i, e := range v {
+			v[i] = redactAuditLogParameters(e)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+var sensitiveAuditLogParameterSubstrings = []string{
+	"body",
+	"certificate",
+	"credential",
+	"document",
+	"passphrase",
+	"password",
+	"payload",
+	"policy",
+	"privatekey",
+	"secret",
+	"signature",
+	"token",
+	"userdata",
+}
+
+func isSensitiveAuditLogParameter(k string) bool {
+	k = strings.ToLower(k)
+
+	for _, v := range sensitiveAuditLogParameterSubstrings {
+		if strings.Contains(k, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// middleware returns the audit logger as AWS SDK for Go v2 middleware.
+// The middleware is added at the start of the stack so that a single record covers all attempts.
+func (l *auditLogger) middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("AuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			record := &auditLogRecord{
+				Time:       start.UTC(),
+				Service:    awsmiddleware_sdkv2.GetServiceID(ctx),
+				Operation:  awsmiddleware_sdkv2.GetOperationName(ctx),
+				Region:     awsmiddleware_sdkv2.GetRegion(ctx),
+				DurationMS: time.Since(start).Milliseconds(),
+				Parameters: l.parameters(in.Parameters),
+			}
+
+			if v, ok := awsmiddleware_sdkv2.GetRequestIDMetadata(metadata); ok {
+				record.RequestID = v
+			}
+
+			if v, ok := awsmiddleware_sdkv2.GetRawResponse(metadata).(*smithyhttp.Response); ok && v != nil {
+				record.HTTPStatus = v.StatusCode
+			}
+
+			if v, ok := retry_sdkv2.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				record.RetryCount = len(v.Results) - 1
+			}
+
+			if err != nil {
+				var re *awshttp_sdkv2.ResponseError
+				if errors.As(err, &re) {
+					record.HTTPStatus = re.HTTPStatusCode()
+					record.RequestID = re.ServiceRequestID()
+				}
+
+				var ae smithy.APIError
+				if errors.As(err, &ae) {
+					record.ErrorCode = ae.ErrorCode()
+				} else {
+					record.ErrorCode = "ClientError"
+				}
+			}
+
+			l.write(ctx, record)
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+// withAuditLog adds the audit logger's middleware to the specified AWS SDK for Go v2 configuration.
+func (l *auditLogger) withAuditLog(cfg *aws_sdkv2.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, l.middleware())
+}
+
+// withAuditLogSDKv1 adds the audit logger's handler to the specified AWS SDK for Go v1 session.
+func (l *auditLogger) withAuditLogSDKv1(sess *session_sdkv1.Session) {
+	sess.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf-aws.AuditLog",
+		Fn: func(r *request_sdkv1.Request) {
+			record := &auditLogRecord{
+				Time:       r.Time.UTC(),
+				Service:    r.ClientInfo.ServiceID,
+				Operation:  r.Operation.Name,
+				Region:     aws_sdkv2.ToString(r.Config.Region),
+				RequestID:  r.RequestID,
+				DurationMS: time.Since(r.Time).Milliseconds(),
+				RetryCount: r.RetryCount,
+				Parameters: l.parameters(r.Params),
+			}
+
+			if r.HTTPResponse != nil {
+				record.HTTPStatus = r.HTTPResponse.StatusCode
+			}
+
+			if err := r.Error; err != nil {
+				var ae awserr_sdkv1.Error
+				if errors.As(err, &ae) {
+					record.ErrorCode = ae.Code()
+				} else {
+					record.ErrorCode = "ClientError"
+				}
+			}
+
+			l.write(r.Context(), record)
+		},
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAuditLoggerParameters(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		Name         string
+		SecretString *string
+	}
+	type input struct {
+		Items    []nested
+		Password string
+		UserData *string
+		VpcId    *string
+	}
+
+	secret, userData, vpcID := "s3cr3t", "IyEvYmluL2Jhc2g=", "vpc-12345678"
+	params := &input{
+		Items: []nested{
+			{Name: "a", SecretString: &secret},
+		},
+		Password: "hunter2",
+		UserData: &userData,
+		VpcId:    &vpcID,
+	}
+
+	testCases := []struct {
+		name              string
+		includeParameters bool
+		want              any
+	}{
+		{
+			name: "excluded",
+		},
+		{
+			name:              "redacted",
+			includeParameters: true,
+			want: map[string]any{
+				"Items": []any{
+					map[string]any{
+						"Name":         "a",
+						"SecretString": auditLogRedacted,
+					},
+				},
+				"Password": auditLogRedacted,
+				"UserData": auditLogRedacted,
+				"VpcId":    vpcID,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := &auditLogger{includeParameters: testCase.includeParameters}
+
+			if diff := cmp.Diff(logger.parameters(params), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAuditLoggerWrite(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := &auditLogger{file: &auditLogFile{w: &buf}}
+
+	ctx := NewResourceContext(context.TODO(), "ec2", "VPC", "aws_vpc")
+	SetPhase(ctx, PhaseRead, "vpc-12345678")
+
+	logger.write(ctx, &auditLogRecord{
+		Service:    "EC2",
+		Operation:  "DescribeVpcs",
+		HTTPStatus: 200,
+	})
+	logger.write(context.TODO(), &auditLogRecord{
+		Service:   "STS",
+		Operation: "GetCallerIdentity",
+		ErrorCode: "ExpiredToken",
+	})
+
+	dec := json.NewDecoder(&buf)
+	var got []auditLogRecord
+	for dec.More() {
+		var v auditLogRecord
+		if err := dec.Decode(&v); err != nil {
+			t.Fatalf("decoding record: %s", err)
+		}
+		got = append(got, v)
+	}
+
+	want := []auditLogRecord{
+		{
+			ResourceType: "aws_vpc",
+			ResourceID:   "vpc-12345678",
+			Phase:        PhaseRead,
+			Service:      "EC2",
+			Operation:    "DescribeVpcs",
+			HTTPStatus:   200,
+		},
+		{
+			Service:   "STS",
+			Operation: "GetCallerIdentity",
+			ErrorCode: "ExpiredToken",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewAuditLoggerSharedFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	l1, err := newAuditLogger(&AuditLogConfig{Path: path, IncludeParameters: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	l2, err := newAuditLogger(&AuditLogConfig{Path: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if l1.file != l2.file {
+		t.Errorf("expected audit loggers to share a file")
+	}
+	if !l1.includeParameters {
+		t.Errorf("expected first audit logger to include parameters")
+	}
+	if l2.includeParameters {
+		t.Errorf("expected second audit logger not to include parameters")
+	}
+}
@@ -13,10 +13,13 @@ import (
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
 	efs_sdkv1 "github.com/aws/aws-sdk-go/service/efs"
@@ -30,12 +33,15 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
+	AccountID            string
+	DefaultTagsConfig    *tftags.DefaultConfig
+	IgnoreTagsConfig     *tftags.IgnoreConfig
+	Partition            string
+	PreventDestroyConfig *PreventDestroyConfig
+	Region               string
+	ServicePackages      map[string]ServicePackage
+	TagPolicyConfig      *tftags.PolicyConfig
+	TimeoutMultiplier    float64 // Scales default resource timeouts.
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
@@ -45,11 +51,14 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	parent                    *AWSClient            // For per-Region clients, the provider's default client.
+	regionalClients           map[string]*AWSClient // Lazily-created per-Region clients, keyed by AWS Region.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3UsePathStyle            bool                       // From provider configuration.
+	s3USEast1RegionalEndpoint string                     // From provider configuration.
+	serviceLimiters           map[string]*serviceLimiter // Keyed by service package name.
+	stsRegion                 string                     // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -60,10 +69,106 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws_sdkv2.CredentialsPr
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:ci.aws-in-func-name
+func (c *AWSClient) AwsConfig(ctx context.Context) aws_sdkv2.Config { // nosemgrep:ci.aws-in-func-name
+	c = errs.Must(c.ForContext(ctx))
+
 	return c.awsConfig.Copy()
 }
 
+// ForRegion returns an AWSClient for the specified AWS Region.
+// The returned client shares the provider's credentials, endpoint overrides and other configuration.
+// Per-Region clients are created lazily and cached; their AWS API clients are also cached.
+func (c *AWSClient) ForRegion(ctx context.Context, region string) (*AWSClient, error) {
+	if region == "" || region == c.Region {
+		return c, nil
+	}
+
+	if c.parent != nil {
+		return c.parent.ForRegion(ctx, region)
+	}
+
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); ok && c.Partition != "" && p.ID() != c.Partition {
+		return nil, fmt.Errorf("AWS Region (%s) is not in the provider's AWS partition (%s)", region, c.Partition)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if v, ok := c.regionalClients[region]; ok {
+		return v, nil
+	}
+
+	tflog.Debug(ctx, "Creating per-Region AWS client", map[string]any{
+		"tf_aws.region": region,
+	})
+
+	client := &AWSClient{
+		AccountID:            c.AccountID,
+		DefaultTagsConfig:    c.DefaultTagsConfig,
+		IgnoreTagsConfig:     c.IgnoreTagsConfig,
+		Partition:            c.Partition,
+		PreventDestroyConfig: c.PreventDestroyConfig,
+		Region:               region,
+		ServicePackages:      c.ServicePackages,
+		TagPolicyConfig:      c.TagPolicyConfig,
+		TimeoutMultiplier:    c.TimeoutMultiplier,
+
+		clients:                   make(map[string]any, 0),
+		conns:                     make(map[string]any, 0),
+		dnsSuffix:                 c.dnsSuffix,
+		endpoints:                 c.endpoints,
+		httpClient:                c.httpClient,
+		logger:                    c.logger,
+		parent:                    c,
+		s3UsePathStyle:            c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint: c.s3USEast1RegionalEndpoint,
+		serviceLimiters:           c.serviceLimiters,
+		stsRegion:                 c.stsRegion,
+	}
+
+	if c.awsConfig != nil {
+		awsConfig := c.awsConfig.Copy()
+		awsConfig.Region = region
+		client.awsConfig = &awsConfig
+	}
+
+	if c.session != nil {
+		client.session = c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]*AWSClient)
+	}
+	c.regionalClients[region] = client
+
+	return client, nil
+}
+
+// ForContext returns the AWSClient for any AWS Region override in Context.
+func (c *AWSClient) ForContext(ctx context.Context) (*AWSClient, error) {
+	if v, ok := FromContext(ctx); ok && v.OverrideRegion != "" {
+		return c.ForRegion(ctx, v.OverrideRegion)
+	}
+
+	return c, nil
+}
+
+// SplitImportIDRegion splits an import ID of the form `<id>@<region>` into its resource ID and AWS Region.
+// The Region is only recognized if it is a known AWS Region, otherwise the import ID is returned unchanged.
+func SplitImportIDRegion(id string) (string, string) {
+	i := strings.LastIndex(id, "@")
+	if i < 0 {
+		return id, ""
+	}
+
+	region := id[i+1:]
+	if _, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); !ok {
+		return id, ""
+	}
+
+	return id[:i], region
+}
+
 // DSConnForRegion returns an AWS SDK For Go v1 DS API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
@@ -122,6 +227,7 @@ func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sd
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
+	c = errs.Must(c.ForContext(ctx))
 	s3Client := c.S3Client(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
@@ -158,6 +264,38 @@ func (c *AWSClient) HTTPClient(context.Context) *http.Client {
 	return c.httpClient
 }
 
+// DisableRetries prevents AWS API calls that fail with an error matched by f from being retried.
+// To have effect it must be called before any AWS API clients are created.
+func (c *AWSClient) DisableRetries(_ context.Context, f func(error) bool) {
+	if c.awsConfig != nil {
+		retryer := c.awsConfig.Retryer
+		c.awsConfig.Retryer = func() aws_sdkv2.Retryer {
+			var r aws_sdkv2.RetryerV2
+			if retryer != nil {
+				r, _ = retryer().(aws_sdkv2.RetryerV2)
+			}
+			if r == nil {
+				r = retry_sdkv2.NewStandard()
+			}
+
+			return AddIsErrorRetryables(r, retry_sdkv2.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+				if f(err) {
+					return aws_sdkv2.FalseTernary
+				}
+				return aws_sdkv2.UnknownTernary // Delegate to configured Retryer.
+			}))
+		}
+	}
+
+	if c.session != nil {
+		c.session.Handlers.AfterRetry.PushFront(func(r *request_sdkv1.Request) {
+			if r.Error != nil && f(r.Error) {
+				r.Retryable = aws_sdkv1.Bool(false)
+			}
+		})
+	}
+}
+
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
 func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
@@ -272,6 +410,9 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	if v, ok := c.serviceLimiters[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = v.withServiceLimits(c.awsConfig)
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -347,6 +488,13 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
+	// Any AWS Region override in Context selects a per-Region client.
+	c, err := c.ForContext(ctx)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
@@ -409,6 +557,13 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
+	// Any AWS Region override in Context selects a per-Region client.
+	c, err := c.ForContext(ctx)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
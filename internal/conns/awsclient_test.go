@@ -5,7 +5,12 @@ package conns
 
 import (
 	"context"
+	"errors"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +186,148 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientForRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	client := &AWSClient{
+		dnsSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	got, err := client.ForRegion(ctx, "us-west-2") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != client {
+		t.Errorf("expected default client for default Region")
+	}
+
+	regional, err := client.ForRegion(ctx, "us-east-1") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := regional.Region, "us-east-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, want %s", got, want)
+	}
+	if got, want := regional.RegionalHostname(ctx, "test"), "test.us-east-1.amazonaws.com"; got != want { //lintignore:AWSAT003
+		t.Errorf("RegionalHostname = %s, want %s", got, want)
+	}
+
+	got, err = client.ForRegion(ctx, "us-east-1") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != regional {
+		t.Errorf("expected cached per-Region client")
+	}
+
+	got, err = regional.ForRegion(ctx, "us-west-2") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != client {
+		t.Errorf("expected default client from per-Region client")
+	}
+
+	if _, err := client.ForRegion(ctx, "cn-north-1"); err == nil { //lintignore:AWSAT003
+		t.Errorf("expected error for Region in another partition")
+	}
+}
+
+func TestAWSClientDisableRetries(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	errNotRetryable := errors.New("not retryable")
+	client := &AWSClient{
+		awsConfig: &aws_sdkv2.Config{
+			Retryer: func() aws_sdkv2.Retryer {
+				return retry.AddWithErrorCodes(retry.NewStandard(), "Throttled")
+			},
+		},
+	}
+
+	client.DisableRetries(ctx, func(err error) bool {
+		return errors.Is(err, errNotRetryable)
+	})
+
+	retryer := client.awsConfig.Retryer()
+	if retryer.IsErrorRetryable(errNotRetryable) {
+		t.Errorf("expected error to not be retryable")
+	}
+	if !retryer.IsErrorRetryable(&smithy.GenericAPIError{Code: "Throttled"}) { // Configured Retryer is still used.
+		t.Errorf("expected error to be retryable")
+	}
+}
+
+func TestAWSClientForContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	ctx := NewResourceContext(context.TODO(), "ec2", "VPC", "aws_vpc")
+	got, err := client.ForContext(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != client {
+		t.Errorf("expected default client without Region override")
+	}
+
+	inContext, _ := FromContext(ctx)
+	inContext.OverrideRegion = "eu-west-1" //lintignore:AWSAT003
+
+	got, err = client.ForContext(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := got.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, want %s", got, want)
+	}
+}
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id         string
+		wantID     string
+		wantRegion string
+	}{
+		{
+			id:     "vpc-12345678",
+			wantID: "vpc-12345678",
+		},
+		{
+			id:         "vpc-12345678@us-east-1", //lintignore:AWSAT003
+			wantID:     "vpc-12345678",
+			wantRegion: "us-east-1", //lintignore:AWSAT003
+		},
+		{
+			id:     "user@example.com",
+			wantID: "user@example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion := SplitImportIDRegion(testCase.id)
+
+			if gotID != testCase.wantID {
+				t.Errorf("id = %s, want %s", gotID, testCase.wantID)
+			}
+			if gotRegion != testCase.wantRegion {
+				t.Errorf("region = %s, want %s", gotRegion, testCase.wantRegion)
+			}
+		})
+	}
+}
@@ -10,7 +10,10 @@ import (
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -29,8 +32,10 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	APICallGuard                   APICallGuard
+	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogConfig                 *AuditLogConfig
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -44,12 +49,14 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PreventDestroyConfig           *PreventDestroyConfig
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceLimits                  map[string]ServiceLimits // Keyed by service package name.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -57,7 +64,9 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
+	TimeoutMultiplier              float64 // Scales default resource timeouts.
 	Token                          string
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
@@ -114,8 +123,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// A single IAM Role is assumed by aws-sdk-go-base, which ignores an assume_role block without role_arn.
+	// The IAM Roles in a chain are all assumed by assumeRoleChain.
+	if len(c.AssumeRole) == 1 && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = &c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -166,8 +177,32 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if len(c.AssumeRole) > 1 {
+		tflog.Debug(ctx, "Assuming chained IAM Roles")
+		if err := c.assumeRoleChain(ctx, &cfg); err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
+	var auditLogger *auditLogger
+	if c.AuditLogConfig != nil && c.AuditLogConfig.Path != "" {
+		tflog.Debug(ctx, "Configuring AWS API call audit log", map[string]any{
+			"tf_aws.audit_log.path": c.AuditLogConfig.Path,
+		})
+		v, err := newAuditLogger(c.AuditLogConfig)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		auditLogger = v
+		auditLogger.withAuditLog(&cfg)
+	}
+
+	if c.APICallGuard != nil {
+		c.APICallGuard.withAPICallGuard(&cfg)
+	}
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	session, awsDiags := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 
@@ -183,6 +218,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if auditLogger != nil {
+		auditLogger.withAuditLogSDKv1(session)
+	}
+
+	if c.APICallGuard != nil {
+		c.APICallGuard.withAPICallGuardSDKv1(session)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -214,7 +257,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
+	client.PreventDestroyConfig = c.PreventDestroyConfig
 	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
+	client.TimeoutMultiplier = c.TimeoutMultiplier
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -226,11 +272,79 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceLimiters = newServiceLimiters(c.ServiceLimits)
 	client.stsRegion = c.STSRegion
 
 	return client, diags
 }
 
+// assumeRoleChain assumes each IAM Role in the configured chain in turn,
+// the first using the configured credentials and each subsequent one using the credentials of the previously assumed IAM Role.
+// Any error identifies the failing IAM Role by its position in the chain.
+func (c *Config) assumeRoleChain(ctx context.Context, cfg *aws_sdkv2.Config) error {
+	n := len(c.AssumeRole)
+
+	for i, ar := range c.AssumeRole {
+		if ar.RoleARN == "" {
+			return fmt.Errorf("assume_role %d of %d: role_arn is required", i+1, n)
+		}
+	}
+
+	for i, ar := range c.AssumeRole {
+		ctx := tflog.SetField(ctx, "tf_aws.assume_role.index", i)
+		ctx = tflog.SetField(ctx, "tf_aws.assume_role.role_arn", ar.RoleARN)
+		tflog.Info(ctx, "Assuming IAM Role")
+
+		client := sts_sdkv2.NewFromConfig(*cfg, func(o *sts_sdkv2.Options) {
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+			if v := c.Endpoints[names.STS]; v != "" {
+				o.BaseEndpoint = aws_sdkv2.String(v)
+			}
+		})
+		provider := stscreds_sdkv2.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds_sdkv2.AssumeRoleOptions) {
+			if ar.Duration > 0 {
+				o.Duration = ar.Duration
+			}
+			if ar.ExternalID != "" {
+				o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+			}
+			if ar.Policy != "" {
+				o.Policy = aws_sdkv2.String(ar.Policy)
+			}
+			for _, v := range ar.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+					Arn: aws_sdkv2.String(v),
+				})
+			}
+			if ar.SessionName != "" {
+				o.RoleSessionName = ar.SessionName
+			}
+			if ar.SourceIdentity != "" {
+				o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+			}
+			for k, v := range ar.Tags {
+				o.Tags = append(o.Tags, ststypes_sdkv2.Tag{
+					Key:   aws_sdkv2.String(k),
+					Value: aws_sdkv2.String(v),
+				})
+			}
+			o.TransitiveTagKeys = ar.TransitiveTagKeys
+		})
+		cache := aws_sdkv2.NewCredentialsCache(provider)
+
+		// Retrieve credentials now so that any error identifies the failing IAM Role.
+		if _, err := cache.Retrieve(ctx); err != nil {
+			return fmt.Errorf("assume_role %d of %d: assuming IAM Role (%s): %w", i+1, n, ar.RoleARN, err)
+		}
+
+		cfg.Credentials = cache
+	}
+
+	return nil
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
@@ -27,27 +27,43 @@ var (
 	contextKey contextKeyType
 )
 
+// Resource CRUD phases kept in Context.
+const (
+	PhaseCreate = "create"
+	PhaseDelete = "delete"
+	PhaseImport = "import"
+	PhasePlan   = "plan"
+	PhaseRead   = "read"
+	PhaseUpdate = "update"
+)
+
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	OverrideRegion     string // AWS Region override, e.g. from the resource's "region" argument
+	Phase              string // CRUD phase, e.g. "create"
+	ResourceID         string // Resource ID, if known
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
+	TypeName           string // Terraform type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -57,3 +73,11 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
 }
+
+// SetPhase records the CRUD phase and resource ID in the resource information kept in Context.
+func SetPhase(ctx context.Context, phase, id string) {
+	if v, ok := FromContext(ctx); ok {
+		v.Phase = phase
+		v.ResourceID = id
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"path"
+)
+
+// PreventDestroyConfig contains rules that protect matching resources from destruction.
+type PreventDestroyConfig struct {
+	Rules []PreventDestroyRule
+}
+
+// PreventDestroyRule matches resources by type and/or by tags.
+// A rule with neither resource types nor tags matches no resources.
+type PreventDestroyRule struct {
+	// ResourceTypes are glob patterns, e.g. "aws_db_*", matched against the resource type name.
+	ResourceTypes []string
+	// Tags are key-value pairs, all of which must be present in the resource's tags.
+	Tags map[string]string
+}
+
+// Match returns the index of the first rule that matches the specified resource type and tags.
+// It returns false if no rule matches.
+func (pdc *PreventDestroyConfig) Match(typeName string, tags map[string]string) (int, bool) {
+	if pdc == nil {
+		return 0, false
+	}
+
+	for i, rule := range pdc.Rules {
+		if rule.matches(typeName, tags) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func (r PreventDestroyRule) matches(typeName string, tags map[string]string) bool {
+	if len(r.ResourceTypes) == 0 && len(r.Tags) == 0 {
+		return false
+	}
+
+	if len(r.ResourceTypes) > 0 {
+		var ok bool
+		for _, pattern := range r.ResourceTypes {
+			if v, err := path.Match(pattern, typeName); err == nil && v {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	for k, v := range r.Tags {
+		if w, ok := tags[k]; !ok || w != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PreventDestroyError returns an error for a resource that is protected from destruction by the specified rule.
+func PreventDestroyError(typeName, id string, rule int) error {
+	return fmt.Errorf("%s (%s) is protected from destruction by provider prevent_destroy rule %d. "+
+		"To destroy this resource, remove or change the rule in the provider configuration", typeName, id, rule+1)
+}
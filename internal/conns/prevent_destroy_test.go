@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestPreventDestroyConfigMatch(t *testing.T) {
+	t.Parallel()
+
+	preventDestroyConfig := &PreventDestroyConfig{
+		Rules: []PreventDestroyRule{
+			{},
+			{
+				ResourceTypes: []string{"aws_db_*", "aws_dynamodb_table"},
+			},
+			{
+				Tags: map[string]string{
+					"Environment": "prod",
+				},
+			},
+			{
+				ResourceTypes: []string{"aws_s3_bucket"},
+				Tags: map[string]string{
+					"DataClassification": "",
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		preventDestroyConfig *PreventDestroyConfig
+		typeName             string
+		tags                 map[string]string
+		wantRule             int
+		wantOK               bool
+	}{
+		{
+			name:     "no config",
+			typeName: "aws_db_instance",
+		},
+		{
+			name:                 "no match",
+			preventDestroyConfig: preventDestroyConfig,
+			typeName:             "aws_vpc",
+			tags: map[string]string{
+				"Environment": "dev",
+			},
+		},
+		{
+			name:                 "resource type glob",
+			preventDestroyConfig: preventDestroyConfig,
+			typeName:             "aws_db_instance",
+			wantRule:             1,
+			wantOK:               true,
+		},
+		{
+			name:                 "resource type exact",
+			preventDestroyConfig: preventDestroyConfig,
+			typeName:             "aws_dynamodb_table",
+			wantRule:             1,
+			wantOK:               true,
+		},
+		{
+			name:                 "tags",
+			preventDestroyConfig: preventDestroyConfig,
+			typeName:             "aws_vpc",
+			tags: map[string]string{
+				"Environment": "prod",
+				"Owner":       "platform",
+			},
+			wantRule: 2,
+			wantOK:   true,
+		},
+		{
+			name:                 "resource type and empty tag value",
+			preventDestroyConfig: preventDestroyConfig,
+			typeName:             "aws_s3_bucket",
+			tags: map[string]string{
+				"DataClassification": "",
+			},
+			wantRule: 3,
+			wantOK:   true,
+		},
+		{
+			name:                 "resource type and missing tag",
+			preventDestroyConfig: preventDestroyConfig,
+			typeName:             "aws_s3_bucket",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotRule, gotOK := testCase.preventDestroyConfig.Match(testCase.typeName, testCase.tags)
+
+			if got, want := gotOK, testCase.wantOK; got != want {
+				t.Errorf("Match() ok = %v, want %v", got, want)
+			}
+			if got, want := gotRule, testCase.wantRule; got != want {
+				t.Errorf("Match() rule = %v, want %v", got, want)
+			}
+		})
+	}
+}
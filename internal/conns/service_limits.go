@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ServiceLimits contains client-side limits on the AWS API calls made by a service package's API clients.
+// A zero value disables the corresponding limit.
+type ServiceLimits struct {
+	// Burst is the maximum number of requests that can be made at once, in excess of Rate.
+	Burst int
+	// MaxConcurrency is the maximum number of requests in flight at any time.
+	MaxConcurrency int
+	// Rate is the sustained number of requests per second.
+	Rate float64
+}
+
+// serviceLimiter enforces a service package's ServiceLimits.
+// A single serviceLimiter is shared by all of a service package's API clients, in all AWS Regions.
+type serviceLimiter struct {
+	bucket    *tokenBucket
+	semaphore chan struct{}
+}
+
+func newServiceLimiter(limits ServiceLimits) *serviceLimiter {
+	limiter := &serviceLimiter{}
+
+	if limits.Rate > 0 {
+		limiter.bucket = newTokenBucket(limits.Rate, limits.Burst)
+	}
+
+	if limits.MaxConcurrency > 0 {
+		limiter.semaphore = make(chan struct{}, limits.MaxConcurrency)
+	}
+
+	return limiter
+}
+
+// newServiceLimiters returns limiters for the specified per-service limits.
+func newServiceLimiters(limits map[string]ServiceLimits) map[string]*serviceLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	limiters := make(map[string]*serviceLimiter, len(limits))
+	for k, v := range limits {
+		limiters[k] = newServiceLimiter(v)
+	}
+
+	return limiters
+}
+
+// acquire waits until a request can be made within the limits.
+// The returned function must be called once the request completes.
+func (l *serviceLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.semaphore != nil {
+			<-l.semaphore
+		}
+	}
+
+	if l.bucket != nil {
+		if err := l.bucket.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// middleware returns the limiter as AWS SDK for Go v2 middleware.
+// The middleware is inserted after the retry middleware so that each attempt counts against the limits.
+func (l *serviceLimiter) middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		m := middleware.FinalizeMiddlewareFunc("ServiceLimits", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			release, err := l.acquire(ctx)
+			if err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			defer release()
+
+			return next.HandleFinalize(ctx, in)
+		})
+
+		if err := stack.Finalize.Insert(m, "Retry", middleware.After); err != nil {
+			return stack.Finalize.Add(m, middleware.After)
+		}
+
+		return nil
+	}
+}
+
+// withServiceLimits returns a copy of the specified AWS SDK for Go v2 configuration with the limiter's middleware added.
+func (l *serviceLimiter) withServiceLimits(cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	if cfg == nil {
+		return nil
+	}
+
+	v := cfg.Copy()
+	v.APIOptions = append(v.APIOptions, l.middleware())
+
+	return &v
+}
+
+// tokenBucket is a simple token bucket rate limiter.
+type tokenBucket struct {
+	burst  float64
+	last   time.Time
+	lock   sync.Mutex
+	rate   float64
+	tokens float64
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	b := float64(burst)
+	if b < 1 {
+		b = math.Max(1, math.Ceil(rate))
+	}
+
+	return &tokenBucket{
+		burst:  b,
+		last:   time.Now(),
+		rate:   rate,
+		tokens: b,
+	}
+}
+
+// wait blocks until a token is available or the Context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.lock.Lock()
+		now := time.Now()
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.lock.Unlock()
+
+			return nil
+		}
+
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.lock.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
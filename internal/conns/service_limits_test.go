@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bucket := newTokenBucket(10, 2)
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := bucket.wait(ctx); err != nil {
+			t.Fatalf("wait %d: %s", i, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("burst elapsed = %s, want immediate", elapsed)
+	}
+
+	if err := bucket.wait(ctx); err != nil {
+		t.Fatalf("wait: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("elapsed = %s, want at least 50ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	if err := bucket.wait(ctx); err == nil {
+		t.Error("expected error from cancelled Context")
+	}
+}
+
+func TestNewTokenBucketDefaultBurst(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		rate  float64
+		burst int
+		want  float64
+	}{
+		{
+			name: "fractional rate",
+			rate: 0.5,
+			want: 1,
+		},
+		{
+			name: "rate",
+			rate: 2.5,
+			want: 3,
+		},
+		{
+			name:  "burst",
+			rate:  2.5,
+			burst: 10,
+			want:  10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := newTokenBucket(testCase.rate, testCase.burst).burst, testCase.want; got != want {
+				t.Errorf("burst = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestServiceLimiterMaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiter := newServiceLimiter(ServiceLimits{MaxConcurrency: 1})
+
+	release, err := limiter.acquire(ctx)
+	if err != nil {
+		t.Fatalf("acquire: %s", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.acquire(timeoutCtx); err == nil {
+		t.Error("expected error acquiring in excess of max concurrency")
+	}
+
+	release()
+
+	release, err = limiter.acquire(ctx)
+	if err != nil {
+		t.Fatalf("acquire after release: %s", err)
+	}
+	release()
+}
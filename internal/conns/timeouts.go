@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"time"
+)
+
+// ScaleTimeout returns the specified default timeout value scaled by the specified multiplier.
+// The value is returned unchanged if the multiplier is not positive.
+func ScaleTimeout(timeout time.Duration, multiplier float64) time.Duration {
+	if multiplier <= 0 || multiplier == 1 {
+		return timeout
+	}
+
+	return time.Duration(float64(timeout) * multiplier)
+}
+
+// ScaleTimeout returns the specified default timeout value scaled by the provider's timeout multiplier.
+func (c *AWSClient) ScaleTimeout(timeout time.Duration) time.Duration {
+	return ScaleTimeout(timeout, c.TimeoutMultiplier)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScaleTimeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		timeout    time.Duration
+		multiplier float64
+		want       time.Duration
+	}{
+		{
+			name:    "no multiplier",
+			timeout: 10 * time.Minute,
+			want:    10 * time.Minute,
+		},
+		{
+			name:       "negative multiplier",
+			timeout:    10 * time.Minute,
+			multiplier: -2,
+			want:       10 * time.Minute,
+		},
+		{
+			name:       "unit multiplier",
+			timeout:    10 * time.Minute,
+			multiplier: 1,
+			want:       10 * time.Minute,
+		},
+		{
+			name:       "integer multiplier",
+			timeout:    10 * time.Minute,
+			multiplier: 3,
+			want:       30 * time.Minute,
+		},
+		{
+			name:       "fractional multiplier",
+			timeout:    10 * time.Minute,
+			multiplier: 1.5,
+			want:       15 * time.Minute,
+		},
+		{
+			name:       "zero timeout",
+			multiplier: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ScaleTimeout(testCase.timeout, testCase.multiplier), testCase.want; got != want {
+				t.Errorf("ScaleTimeout(%s, %v) = %s, want %s", testCase.timeout, testCase.multiplier, got, want)
+			}
+		})
+	}
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 // Expand  = TF -->  AWS
@@ -77,6 +76,11 @@ func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.V
 		return diags
 	}
 
+	if f, ok := converterFor(valFrom.Type(), vTo.Type()); ok {
+		diags.Append(f(ctx, valFrom, vTo)...)
+		return diags
+	}
+
 	switch vFrom := vFrom.(type) {
 	// Primitive types.
 	case basetypes.BoolValuable:
@@ -282,15 +286,20 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		}
 
 	case reflect.Interface:
-		if s, ok := vFrom.(fwtypes.SmithyJSON[smithyjson.JSONStringer]); ok {
-			v, d := s.ValueInterface()
+		//
+		// fwtypes.SmithyJSON -> Smithy document.
+		//
+		if s, ok := vFrom.(fwtypes.SmithyDocumentValuable); ok {
+			v, d := s.ValueSmithyDocument()
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(v))
-			return diags
+			if v != nil && reflect.TypeOf(v).AssignableTo(tTo) {
+				vTo.Set(reflect.ValueOf(v))
+				return diags
+			}
 		}
 
 	case reflect.Ptr:
@@ -358,6 +367,15 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object -> union.
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -723,17 +741,17 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 
 		case reflect.Interface:
 			//
-			// types.List(OfObject) -> []interface.
+			// types.List(OfObject) -> []union.
 			//
-			// Smithy union type handling not yet implemented. Silently skip.
+			diags.Append(expander.nestedObjectToSliceOfUnion(ctx, vFrom, tTo, tElem, vTo)...)
 			return diags
 		}
 
 	case reflect.Interface:
 		//
-		// types.List(OfObject) -> interface.
+		// types.List(OfObject) -> union.
 		//
-		// Smithy union type handling not yet implemented. Silently skip.
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
 		return diags
 	}
 
@@ -805,6 +823,116 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API (Smithy) union value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	member, d := expander.unionMember(ctx, from, tUnion)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if member.IsValid() {
+		vTo.Set(member)
+	}
+
+	return diags
+}
+
+// nestedObjectToSliceOfUnion copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API (Smithy) []union value.
+func (expander autoExpander) nestedObjectToSliceOfUnion(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tSlice, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create a new target slice and expand each element.
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, 0, n)
+	for i := 0; i < n; i++ {
+		member, d := expander.unionMember(ctx, f.Index(i).Interface(), tUnion)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Elements with no member set are dropped.
+		if member.IsValid() {
+			t = reflect.Append(t, member)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// unionMember expands a Terraform model (Go *struct) into a member of the specified AWS API (Smithy) union type.
+// Exactly one of the model's fields corresponding to a registered union member may be set.
+// An invalid Value is returned if no member is set.
+func (expander autoExpander) unionMember(ctx context.Context, from any, tUnion reflect.Type) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	members, ok := unionMembersFor(tUnion)
+	if !ok {
+		tflog.Info(ctx, "AutoFlex Expand; unregistered union type", map[string]interface{}{
+			"to": tUnion,
+		})
+		return reflect.Value{}, diags
+	}
+
+	valFrom := reflect.Indirect(reflect.ValueOf(from))
+	if valFrom.Kind() != reflect.Struct {
+		diags.AddError("AutoFlEx", fmt.Sprintf("wrong type (%T), expected struct", from))
+		return reflect.Value{}, diags
+	}
+
+	var member reflect.Value
+	for _, tMember := range members {
+		field, ok := unionMemberField(valFrom.Type(), unionMemberName(tUnion, tMember))
+		if !ok {
+			continue
+		}
+
+		fieldVal := valFrom.FieldByIndex(field.Index)
+		if v, ok := fieldVal.Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if member.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("more than one member of union type %s is set", tUnion))
+			return reflect.Value{}, diags
+		}
+
+		member = reflect.New(tMember.Elem())
+		vValue := member.Elem().FieldByName(unionMemberValueFieldName)
+		if !vValue.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union member %s has no %s field", tMember, unionMemberValueFieldName))
+			return reflect.Value{}, diags
+		}
+
+		diags.Append(expander.convert(ctx, fieldVal, vValue)...)
+		if diags.HasError() {
+			return reflect.Value{}, diags
+		}
+	}
+
+	return member, diags
+}
+
 // nestedKeyObjectToMap copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API map[string]struct value.
 func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
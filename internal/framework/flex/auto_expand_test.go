@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 func TestExpand(t *testing.T) {
@@ -1180,6 +1181,179 @@ func TestExpandOptions(t *testing.T) {
 	runAutoExpandTestCases(ctx, t, testCases)
 }
 
+func TestExpandFieldNameTag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "explicitly mapped and ignored fields",
+			Source: &TestFlexFieldNameTF01{
+				Name:        types.StringValue("a"),
+				Description: types.StringValue("b"),
+				Field1:      types.StringValue("c"),
+			},
+			Target: &TestFlexFieldNameAWS01{},
+			WantTarget: &TestFlexFieldNameAWS01{
+				Title:  aws.String("a"),
+				Field1: "c",
+			},
+		},
+		{
+			TestName: "explicitly mapped field not found",
+			Source: &TestFlexFieldNameTF01{
+				Name:   types.StringValue("a"),
+				Field1: types.StringValue("c"),
+			},
+			Target:  &TestFlexAWS01{},
+			WantErr: true,
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandRegisteredConverter(t *testing.T) {
+	t.Parallel()
+
+	registerTestFlexConverters()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "null value",
+			Source: &TestFlexConverterTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+			},
+			Target:     &TestFlexConverterAWS01{},
+			WantTarget: &TestFlexConverterAWS01{},
+		},
+		{
+			TestName: "union member",
+			Source: &TestFlexConverterTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+			},
+			Target: &TestFlexConverterAWS01{},
+			WantTarget: &TestFlexConverterAWS01{
+				Field1: &testFlexUnionMemberValue{Value: "a"},
+			},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	registerTestFlexUnionMembers()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "null value",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionMemberTF01](ctx),
+			},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName: "string member",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionMemberTF01{
+					Name:     types.StringValue("a"),
+					Nested:   fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+				}),
+			},
+			Target: &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{
+				Field1: &testFlexUnionTypeMemberName{Value: "a"},
+			},
+		},
+		{
+			TestName: "nested object member",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionMemberTF01{
+					Name:     types.StringNull(),
+					Nested:   fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+					Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+				}),
+			},
+			Target: &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{
+				Field1: &testFlexUnionTypeMemberNested{Value: TestFlexAWS01{Field1: "a"}},
+			},
+		},
+		{
+			TestName: "document member mapped by struct tag",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionMemberTF01{
+					Name:     types.StringNull(),
+					Nested:   fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Document: fwtypes.SmithyJSONValue(`{"field1": "a"}`, newTestJSONDocument),
+				}),
+			},
+			Target: &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{
+				Field1: &testFlexUnionTypeMemberDoc{Value: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				}},
+			},
+		},
+		{
+			TestName: "more than one member",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionMemberTF01{
+					Name:     types.StringValue("a"),
+					Nested:   fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+					Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+				}),
+			},
+			Target:  &TestFlexUnionAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "list of unions",
+			Source: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionMemberTF01{
+					{
+						Name:     types.StringValue("a"),
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+						Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+					},
+					{
+						Name:     types.StringNull(),
+						Nested:   fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+						Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+					},
+				}),
+			},
+			Target: &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{
+				Field1: []testFlexUnionType{
+					&testFlexUnionTypeMemberName{Value: "a"},
+					&testFlexUnionTypeMemberNested{Value: TestFlexAWS01{Field1: "b"}},
+				},
+			},
+		},
+		{
+			TestName: "typed document",
+			Source:   &TestFlexTF22{Field1: fwtypes.SmithyJSONValue(`{"field1": "a"}`, newTestJSONDocumentPtr)},
+			Target:   &TestFlexAWS19{},
+			WantTarget: &TestFlexAWS19{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				},
+			},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Context    context.Context //nolint:containedctx // testing context use
 	Options    []AutoFlexOptionsFunc
@@ -76,6 +76,13 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 	}
 
+	if vFrom.IsValid() {
+		if f, ok := converterFor(vFrom.Type(), vTo.Type()); ok {
+			diags.Append(f(ctx, vFrom, vTo)...)
+			return diags
+		}
+	}
+
 	tTo := valTo.Type(ctx)
 	switch k := vFrom.Kind(); k {
 	case reflect.Bool:
@@ -334,7 +341,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 	switch tTo := tTo.(type) {
 	case basetypes.StringTypable:
 		stringValue := types.StringNull()
-		if !isNullFrom {
+		if !isNullFrom && !vFrom.IsNil() {
 			//
 			// JSONStringer -> types.String-ish.
 			//
@@ -356,6 +363,13 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 
 		vTo.Set(reflect.ValueOf(v))
 		return diags
+
+	case fwtypes.NestedObjectType:
+		//
+		// union -> types.List(OfObject) or types.Object.
+		//
+		diags.Append(flattener.unionToNestedObject(ctx, vFrom, isNullFrom || vFrom.IsNil(), tTo, vTo)...)
+		return diags
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -505,8 +519,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
-		return diags
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			//
+			// []union -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfUnionToNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -828,6 +847,148 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, vFrom r
 	return diags
 }
 
+// unionToNestedObject copies an AWS API (Smithy) union value to a compatible Plugin Framework NestedObjectValue value.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isNullFrom {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target structure and set the field corresponding to the union member.
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattener.unionMember(ctx, vFrom, to)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Set the target structure as a mapped Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// sliceOfUnionToNestedObjectCollection copies an AWS API (Smithy) []union value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionToNestedObjectCollection(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and flatten each element.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := tTo.NewObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(flattener.unionMember(ctx, vFrom.Index(i), target)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// unionMember flattens a member of an AWS API (Smithy) union value into a Terraform model (Go *struct).
+// The model field corresponding to the member is set and all other fields are set to null.
+func (flattener autoFlattener) unionMember(ctx context.Context, vFrom reflect.Value, to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to).Elem()
+	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+		if typTo.Field(i).PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		v, err := fwtypes.NullValueOf(ctx, valTo.Field(i).Interface())
+		if err != nil {
+			diags.AddError("AutoFlEx", err.Error())
+			return diags
+		}
+
+		if v != nil {
+			valTo.Field(i).Set(reflect.ValueOf(v))
+		}
+	}
+
+	if vFrom.IsNil() {
+		return diags
+	}
+
+	// The member is a pointer to a struct with a single Value field.
+	vMember := vFrom.Elem()
+	if vMember.Kind() != reflect.Ptr || vMember.IsNil() || vMember.Elem().Kind() != reflect.Struct {
+		diags.AddError("AutoFlEx", fmt.Sprintf("wrong type (%s), expected union member", vMember.Type()))
+		return diags
+	}
+
+	memberName := unionMemberName(vFrom.Type(), vMember.Type())
+	field, ok := unionMemberField(valTo.Type(), memberName)
+	if !ok {
+		tflog.Info(ctx, "AutoFlex Flatten; no field for union member", map[string]interface{}{
+			"from": vMember.Type(),
+			"to":   valTo.Type(),
+		})
+		return diags
+	}
+
+	vValue := vMember.Elem().FieldByName(unionMemberValueFieldName)
+	if !vValue.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union member %s has no %s field", vMember.Type(), unionMemberValueFieldName))
+		return diags
+	}
+
+	diags.Append(flattener.convert(ctx, vValue, valTo.FieldByIndex(field.Index))...)
+
+	return diags
+}
+
 // sliceOfPrimtiveToList copies an AWS API slice of primitive (or pointer to primitive) value to a compatible Plugin Framework List value.
 func (flattener autoFlattener) sliceOfPrimtiveToList(ctx context.Context, vFrom reflect.Value, tTo basetypes.ListTypable, vTo reflect.Value, elementType attr.Type, f attrValueFromReflectValueFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
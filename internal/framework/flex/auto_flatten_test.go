@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 func TestFlatten(t *testing.T) {
@@ -1153,6 +1154,147 @@ func TestFlattenOptions(t *testing.T) {
 	runAutoFlattenTestCases(ctx, t, testCases)
 }
 
+func TestFlattenFieldNameTag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "explicitly mapped and ignored fields",
+			Source: &TestFlexFieldNameAWS01{
+				Title:       aws.String("a"),
+				Name:        aws.String("b"),
+				Description: aws.String("c"),
+				Field1:      "d",
+			},
+			Target: &TestFlexFieldNameTF01{},
+			WantTarget: &TestFlexFieldNameTF01{
+				Name:   types.StringValue("a"),
+				Field1: types.StringValue("d"),
+			},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenRegisteredConverter(t *testing.T) {
+	t.Parallel()
+
+	registerTestFlexConverters()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "nil value",
+			Source:     &TestFlexConverterAWS01{},
+			Target:     &TestFlexConverterTF01{},
+			WantTarget: &TestFlexConverterTF01{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx)},
+		},
+		{
+			TestName: "union member",
+			Source: &TestFlexConverterAWS01{
+				Field1: &testFlexUnionMemberValue{Value: "a"},
+			},
+			Target: &TestFlexConverterTF01{},
+			WantTarget: &TestFlexConverterTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+			},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "nil value",
+			Source:     &TestFlexUnionAWS01{},
+			Target:     &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionMemberTF01](ctx)},
+		},
+		{
+			TestName: "string member",
+			Source: &TestFlexUnionAWS01{
+				Field1: &testFlexUnionTypeMemberName{Value: "a"},
+			},
+			Target: &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionMemberTF01{
+					Name:     types.StringValue("a"),
+					Nested:   fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+				}),
+			},
+		},
+		{
+			TestName: "nested object member",
+			Source: &TestFlexUnionAWS01{
+				Field1: &testFlexUnionTypeMemberNested{Value: TestFlexAWS01{Field1: "a"}},
+			},
+			Target: &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionMemberTF01{
+					Name:     types.StringNull(),
+					Nested:   fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+					Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+				}),
+			},
+		},
+		{
+			TestName: "document member mapped by struct tag",
+			Source: &TestFlexUnionAWS01{
+				Field1: &testFlexUnionTypeMemberDoc{Value: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				}},
+			},
+			Target: &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionMemberTF01{
+					Name:     types.StringNull(),
+					Nested:   fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Document: fwtypes.SmithyJSONValue(`{"field1":"a"}`, newTestJSONDocument),
+				}),
+			},
+		},
+		{
+			TestName: "list of unions",
+			Source: &TestFlexUnionAWS02{
+				Field1: []testFlexUnionType{
+					&testFlexUnionTypeMemberName{Value: "a"},
+					&testFlexUnionTypeMemberNested{Value: TestFlexAWS01{Field1: "b"}},
+				},
+			},
+			Target: &TestFlexUnionTF01{},
+			WantTarget: &TestFlexUnionTF01{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionMemberTF01{
+					{
+						Name:     types.StringValue("a"),
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+						Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+					},
+					{
+						Name:     types.StringNull(),
+						Nested:   fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+						Document: fwtypes.SmithyJSONNull[smithyjson.JSONStringer](),
+					},
+				}),
+			},
+		},
+		{
+			TestName:   "nil document",
+			Source:     &TestFlexAWS19{},
+			Target:     &TestFlexTF20{},
+			WantTarget: &TestFlexTF20{Field1: fwtypes.SmithyJSONNull[smithyjson.JSONStringer]()},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
 func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
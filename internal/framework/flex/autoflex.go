@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
 
 	pluralize "github.com/gertd/go-pluralize"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -22,6 +24,14 @@ const (
 	MapBlockKey                                = "MapBlockKey"
 )
 
+const (
+	// fieldNameTagKey is the key of the struct tag on a Terraform model field that explicitly names
+	// the corresponding AWS API field, for example `autoflex:"ApiSchema"`.
+	// A Terraform model field tagged `autoflex:"-"` is never read from or written to.
+	fieldNameTagKey = "autoflex"
+	fieldNameIgnore = "-"
+)
+
 // Expand  = TF -->  AWS
 // Flatten = AWS --> TF
 
@@ -80,6 +90,14 @@ func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.
 		return diags
 	}
 
+	// Registered converter.
+	if valFrom.IsValid() && valTo.IsValid() {
+		if f, ok := converterFor(valFrom.Type(), valTo.Type()); ok {
+			diags.Append(f(ctx, valFrom, valTo)...)
+			return diags
+		}
+	}
+
 	// Top-level struct to struct conversion.
 	if valFrom.IsValid() && valTo.IsValid() {
 		if typFrom, typTo := valFrom.Type(), valTo.Type(); typFrom.Kind() == reflect.Struct && typTo.Kind() == reflect.Struct {
@@ -138,7 +156,23 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 			continue
 		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom, flexer)
+		var toFieldVal reflect.Value
+		if name, ok := field.Tag.Lookup(fieldNameTagKey); ok {
+			// The "from" field explicitly names its "to" field.
+			if name == fieldNameIgnore {
+				continue
+			}
+			toFieldVal = valTo.FieldByName(name)
+			if !toFieldVal.IsValid() {
+				diags.AddError("AutoFlEx", fmt.Sprintf("field (%s) is tagged with field (%s) not found in target (%s)", fieldName, name, valTo.Type()))
+				return diags
+			}
+		} else if name, ok := taggedFieldName(fieldName, valTo.Type()); ok {
+			// A "to" field explicitly names this "from" field.
+			toFieldVal = valTo.FieldByName(name)
+		} else {
+			toFieldVal = findFieldFuzzy(ctx, fieldName, valTo, valFrom, flexer)
+		}
 		if !toFieldVal.IsValid() {
 			continue // Corresponding field not found in to.
 		}
@@ -158,7 +192,7 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value, flexer autoFlexer) reflect.Value {
 	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
+	if v := untaggedFieldByName(valTo, fieldNameFrom); v.IsValid() {
 		return v
 	}
 
@@ -179,7 +213,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		if opts.IsIgnoredField(fieldNameTo) {
 			continue
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+		if v := untaggedFieldByName(valTo, fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
 			// probably could assume validity here since reflect gave the field name
 			return v
 		}
@@ -187,13 +221,13 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 
 	// third precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
+		if v := untaggedFieldByName(valTo, plural.Plural(fieldNameFrom)); v.IsValid() {
 			return v
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
+		if v := untaggedFieldByName(valTo, plural.Singular(fieldNameFrom)); v.IsValid() {
 			return v
 		}
 	}
@@ -212,7 +246,36 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 	}
 
 	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	return untaggedFieldByName(valTo, fieldNameFrom)
+}
+
+// taggedFieldName returns the name of any field of struct type typ that is explicitly mapped by struct tag
+// to the specified field name.
+func taggedFieldName(fieldName string, typ reflect.Type) (string, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		if name, ok := field.Tag.Lookup(fieldNameTagKey); ok && name == fieldName {
+			return field.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// untaggedFieldByName returns the struct field with the specified name.
+// Fields that are explicitly mapped or ignored by struct tag are never matched by name.
+func untaggedFieldByName(v reflect.Value, name string) reflect.Value {
+	if field, ok := v.Type().FieldByName(name); ok {
+		if _, ok := field.Tag.Lookup(fieldNameTagKey); ok {
+			return reflect.Value{}
+		}
+	}
+
+	return v.FieldByName(name)
 }
 
 func fieldExistsInStruct(field string, str reflect.Value) bool {
@@ -229,3 +292,151 @@ type valueWithElementsAs interface {
 
 	ElementsAs(context.Context, any, bool) diag.Diagnostics
 }
+
+// converterFunc converts a value to the type of a target value.
+type converterFunc func(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics
+
+type converterKey struct {
+	from, to reflect.Type
+}
+
+var converters = struct {
+	sync.RWMutex
+	m map[converterKey]converterFunc
+}{
+	m: make(map[converterKey]converterFunc),
+}
+
+// RegisterExpander registers a function that expands a Terraform value of type From into an AWS API value of type To.
+// Whenever AutoFlEx expands a From value into a To value, including as a struct field, it calls the function instead of
+// converting the value itself. The function is not called for null or unknown values.
+// This is typically used where a value has no structural equivalent, for example a union type.
+func RegisterExpander[From attr.Value, To any](f func(context.Context, From) (To, diag.Diagnostics)) {
+	registerConverter[From, To](f)
+}
+
+// RegisterFlattener registers a function that flattens an AWS API value of type From into a Terraform value of type To.
+// Whenever AutoFlEx flattens a From value into a To value, including as a struct field, it calls the function instead of
+// converting the value itself. The function is called for nil values.
+func RegisterFlattener[From any, To attr.Value](f func(context.Context, From) (To, diag.Diagnostics)) {
+	registerConverter[From, To](f)
+}
+
+func registerConverter[From, To any](f func(context.Context, From) (To, diag.Diagnostics)) {
+	key := converterKey{
+		from: reflect.TypeOf((*From)(nil)).Elem(),
+		to:   reflect.TypeOf((*To)(nil)).Elem(),
+	}
+
+	converters.Lock()
+	defer converters.Unlock()
+
+	converters.m[key] = func(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		var from From
+		if vFrom.IsValid() && !(vFrom.Kind() == reflect.Interface && vFrom.IsNil()) {
+			from = vFrom.Interface().(From)
+		}
+
+		to, d := f(ctx, from)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(&to).Elem())
+
+		return diags
+	}
+}
+
+// converterFor returns any converter registered for values of type from to values of type to.
+func converterFor(from, to reflect.Type) (converterFunc, bool) {
+	converters.RLock()
+	defer converters.RUnlock()
+
+	f, ok := converters.m[converterKey{from: from, to: to}]
+
+	return f, ok
+}
+
+// unionMemberValueFieldName is the name of the field holding the value of a Smithy union member.
+const unionMemberValueFieldName = "Value"
+
+var unions = struct {
+	sync.RWMutex
+	m map[reflect.Type][]reflect.Type
+}{
+	m: make(map[reflect.Type][]reflect.Type),
+}
+
+// RegisterUnionMembers registers the members of the AWS API (Smithy) union type T, for example
+//
+//	RegisterUnionMembers[awstypes.APISchema](&awstypes.APISchemaMemberPayload{}, &awstypes.APISchemaMemberS3{})
+//
+// A Terraform nested object is expanded into a registered union member by matching the model's single non-null field
+// to the member name, either by `autoflex` struct tag or by field name. Members are pointers to the member structs.
+// Flattening a union member needs no registration.
+func RegisterUnionMembers[T any](members ...T) {
+	tUnion := reflect.TypeOf((*T)(nil)).Elem()
+
+	unions.Lock()
+	defer unions.Unlock()
+
+	for _, member := range members {
+		if tMember := reflect.TypeOf(member); !slices.Contains(unions.m[tUnion], tMember) {
+			unions.m[tUnion] = append(unions.m[tUnion], tMember)
+		}
+	}
+}
+
+// unionMembersFor returns the registered members of the specified union type.
+func unionMembersFor(tUnion reflect.Type) ([]reflect.Type, bool) {
+	unions.RLock()
+	defer unions.RUnlock()
+
+	members, ok := unions.m[tUnion]
+
+	return members, ok
+}
+
+// unionMemberName returns the name of a union member, for example `Payload` for `APISchemaMemberPayload`.
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	if tMember.Kind() == reflect.Ptr {
+		tMember = tMember.Elem()
+	}
+
+	name := tMember.Name()
+	if v, ok := strings.CutPrefix(name, tUnion.Name()+"Member"); ok {
+		return v
+	}
+	if i := strings.LastIndex(name, "Member"); i >= 0 {
+		return name[i+len("Member"):]
+	}
+
+	return name
+}
+
+// unionMemberField returns the field of the Terraform model struct typ corresponding to the named union member.
+func unionMemberField(typ reflect.Type, memberName string) (reflect.StructField, bool) {
+	if fieldName, ok := taggedFieldName(memberName, typ); ok {
+		return typ.FieldByName(fieldName)
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if _, ok := field.Tag.Lookup(fieldNameTagKey); ok {
+			continue
+		}
+
+		if strings.EqualFold(field.Name, memberName) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
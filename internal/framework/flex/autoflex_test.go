@@ -4,11 +4,13 @@
 package flex
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
 	smithydocument "github.com/aws/smithy-go/document"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -361,3 +363,120 @@ type TestFlexAWS21 struct {
 type TestFlexAWS22 struct {
 	Field1 map[string]map[string]*string
 }
+
+// Explicitly mapped field names.
+type TestFlexFieldNameTF01 struct {
+	Name        types.String `tfsdk:"name" autoflex:"Title"`
+	Description types.String `tfsdk:"description" autoflex:"-"`
+	Field1      types.String `tfsdk:"field1"`
+}
+
+type TestFlexFieldNameAWS01 struct {
+	Title       *string
+	Name        *string
+	Description *string
+	Field1      string
+}
+
+// Registered converters.
+type TestFlexConverterTF01 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"field1"`
+}
+
+type TestFlexConverterAWS01 struct {
+	Field1 testFlexUnion
+}
+
+// testFlexUnion is an AWS API union type, with a member type for each alternative.
+type testFlexUnion interface {
+	isTestFlexUnion()
+}
+
+type testFlexUnionMemberValue struct {
+	Value string
+}
+
+func (*testFlexUnionMemberValue) isTestFlexUnion() {}
+
+func registerTestFlexConverters() {
+	RegisterExpander(func(ctx context.Context, v fwtypes.ListNestedObjectValueOf[TestFlexTF01]) (testFlexUnion, diag.Diagnostics) {
+		data, diags := v.ToPtr(ctx)
+		if diags.HasError() || data == nil {
+			return nil, diags
+		}
+
+		return &testFlexUnionMemberValue{Value: data.Field1.ValueString()}, diags
+	})
+
+	RegisterFlattener(func(ctx context.Context, v testFlexUnion) (fwtypes.ListNestedObjectValueOf[TestFlexTF01], diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		switch v := v.(type) {
+		case *testFlexUnionMemberValue:
+			return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue(v.Value)}), diags
+		}
+
+		return fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx), diags
+	})
+}
+
+// Smithy union types.
+type TestFlexUnionTF01 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexUnionMemberTF01] `tfsdk:"field1"`
+}
+
+type TestFlexUnionMemberTF01 struct {
+	Name     types.String                                  `tfsdk:"name"`
+	Nested   fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"nested"`
+	Document fwtypes.SmithyJSON[smithyjson.JSONStringer]   `tfsdk:"document" autoflex:"Doc"`
+}
+
+type TestFlexUnionAWS01 struct {
+	Field1 testFlexUnionType
+}
+
+type TestFlexUnionAWS02 struct {
+	Field1 []testFlexUnionType
+}
+
+// testFlexUnionType is an AWS API union type whose members are registered with AutoFlEx.
+type testFlexUnionType interface {
+	isTestFlexUnionType()
+}
+
+type testFlexUnionTypeMemberName struct {
+	Value string
+}
+
+func (*testFlexUnionTypeMemberName) isTestFlexUnionType() {}
+
+type testFlexUnionTypeMemberNested struct {
+	Value TestFlexAWS01
+}
+
+func (*testFlexUnionTypeMemberNested) isTestFlexUnionType() {}
+
+type testFlexUnionTypeMemberDoc struct {
+	Value smithyjson.JSONStringer
+}
+
+func (*testFlexUnionTypeMemberDoc) isTestFlexUnionType() {}
+
+func registerTestFlexUnionMembers() {
+	RegisterUnionMembers[testFlexUnionType](
+		&testFlexUnionTypeMemberName{},
+		&testFlexUnionTypeMemberNested{},
+		&testFlexUnionTypeMemberDoc{},
+	)
+	// As a resource schema would, register the document constructor used by values rebuilt from nested objects.
+	fwtypes.NewSmithyJSONType(context.Background(), newTestJSONDocument)
+}
+
+// Smithy document of a specific type.
+type TestFlexTF22 struct {
+	Field1 fwtypes.SmithyJSON[*testJSONDocument] `tfsdk:"field1"`
+}
+
+func newTestJSONDocumentPtr(v any) *testJSONDocument {
+	return &testJSONDocument{Value: v}
+}
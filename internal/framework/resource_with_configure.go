@@ -6,6 +6,7 @@ package framework
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -52,6 +53,8 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+
+			response.Diagnostics.Append(tagPolicyDiagnostics(r.Meta().TagPolicyConfig, allTags)...)
 		} else {
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
@@ -60,6 +63,23 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	}
 }
 
+// tagPolicyDiagnostics returns a diagnostic for each violation of the provider's tag policy by the specified tags,
+// with the policy's severity.
+func tagPolicyDiagnostics(policyConfig *tftags.PolicyConfig, tags tftags.KeyValueTags) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for severity, newDiagnostic := range map[tftags.PolicySeverity]func(path.Path, string, string) diag.DiagnosticWithPath{
+		tftags.PolicySeverityError:   diag.NewAttributeErrorDiagnostic,
+		tftags.PolicySeverityWarning: diag.NewAttributeWarningDiagnostic,
+	} {
+		for _, v := range policyConfig.ViolationsWithSeverity(tags, severity) {
+			diags.Append(newDiagnostic(path.Root(names.AttrTagsAll), "Tag policy violation", v))
+		}
+	}
+
+	return diags
+}
+
 func mapHasUnknownElements(m types.Map) bool {
 	for _, v := range m.Elements() {
 		if v.IsUnknown() {
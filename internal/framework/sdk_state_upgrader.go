@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StateUpgraderFromSDK returns a state upgrader that runs the specified Plugin SDK state upgrade functions, in order,
+// against the raw prior state.
+// It's intended for resources migrated from the Plugin SDK, whose state upgraders upgrade state one version at a time,
+// whereas a Plugin Framework state upgrader must upgrade state from its prior version directly to the current version.
+// The upgraded state must conform to the resource's current schema.
+func StateUpgraderFromSDK(meta any, upgrades ...schema.StateUpgradeFunc) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			if request.RawState == nil || request.RawState.JSON == nil {
+				response.Diagnostics.AddError("Unable to Upgrade Resource State", "The prior state is not in JSON format.")
+
+				return
+			}
+
+			var rawState map[string]any
+			if err := json.Unmarshal(request.RawState.JSON, &rawState); err != nil {
+				response.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("unmarshaling prior state: %s", err))
+
+				return
+			}
+
+			for _, upgrade := range upgrades {
+				var err error
+				rawState, err = upgrade(ctx, rawState, meta)
+
+				if err != nil {
+					response.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+
+					return
+				}
+			}
+
+			b, err := json.Marshal(rawState)
+
+			if err != nil {
+				response.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("marshaling upgraded state: %s", err))
+
+				return
+			}
+
+			response.DynamicValue = &tfprotov6.DynamicValue{
+				JSON: b,
+			}
+		},
+	}
+}
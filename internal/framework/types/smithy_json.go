@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -28,11 +30,35 @@ type SmithyJSONType[T smithyjson.JSONStringer] struct {
 }
 
 func NewSmithyJSONType[T smithyjson.JSONStringer](_ context.Context, f func(any) T) SmithyJSONType[T] {
+	registerSmithyDocumentConstructor(f)
+
 	return SmithyJSONType[T]{
 		f: f,
 	}
 }
 
+// smithyDocumentConstructors maps a Smithy document type to the function that constructs documents of that type.
+// Values rebuilt from a zero SmithyJSONType (e.g. the attributes of a nested object) have no constructor of their own.
+var smithyDocumentConstructors sync.Map // map[reflect.Type]any
+
+func registerSmithyDocumentConstructor[T smithyjson.JSONStringer](f func(any) T) {
+	if f == nil {
+		return
+	}
+
+	smithyDocumentConstructors.Store(reflect.TypeFor[T](), f)
+}
+
+func smithyDocumentConstructor[T smithyjson.JSONStringer]() func(any) T {
+	if v, ok := smithyDocumentConstructors.Load(reflect.TypeFor[T]()); ok {
+		if f, ok := v.(func(any) T); ok {
+			return f
+		}
+	}
+
+	return nil
+}
+
 // String returns a human readable string of the type name.
 func (t SmithyJSONType[T]) String() string {
 	return "fwtypes.SmithyJSONType"
@@ -101,6 +127,19 @@ var (
 	_ xattr.ValidateableAttribute                = (*SmithyJSON[smithyjson.JSONStringer])(nil)
 )
 
+// SmithyDocumentValuable is implemented by SmithyJSON values of any Smithy document type.
+// It isn't generic on the document type as it's referenced within AutoFlEx.
+type SmithyDocumentValuable interface {
+	basetypes.StringValuable
+
+	// ValueSmithyDocument returns the value as a Smithy document.
+	ValueSmithyDocument() (smithyjson.JSONStringer, diag.Diagnostics)
+}
+
+var (
+	_ SmithyDocumentValuable = (*SmithyJSON[smithyjson.JSONStringer])(nil)
+)
+
 type SmithyJSON[T smithyjson.JSONStringer] struct {
 	basetypes.StringValue
 	validate func(context.Context, tftypes.Value, path.Path) diag.Diagnostics
@@ -125,6 +164,19 @@ func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
 		return zero, diags
 	}
 
+	f := v.f
+	if f == nil {
+		f = smithyDocumentConstructor[T]()
+	}
+	if f == nil {
+		diags.AddError(
+			"Smithy Document Error",
+			fmt.Sprintf("No Smithy document constructor is registered for %s. ", reflect.TypeFor[T]())+
+				"Please report this to the provider developers.",
+		)
+		return zero, diags
+	}
+
 	var data map[string]any
 	err := json.Unmarshal([]byte(v.ValueString()), &data)
 
@@ -138,11 +190,15 @@ func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
 		return zero, diags
 	}
 
-	return v.f(data), diags
+	return f(data), diags
+}
+
+func (v SmithyJSON[T]) ValueSmithyDocument() (smithyjson.JSONStringer, diag.Diagnostics) {
+	return v.ValueInterface()
 }
 
 func (v SmithyJSON[T]) Type(context.Context) attr.Type {
-	return SmithyJSONType[T]{}
+	return SmithyJSONType[T]{f: v.f}
 }
 
 func (v SmithyJSON[T]) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
@@ -170,3 +170,46 @@ func TestSmithyJSONValueInterface(t *testing.T) {
 		})
 	}
 }
+
+type testRegisteredJSONDocument struct {
+	testJSONDocument
+}
+
+type testUnregisteredJSONDocument struct {
+	testJSONDocument
+}
+
+func TestSmithyJSONValueInterfaceNoConstructor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	fwtypes.NewSmithyJSONType(ctx, func(v any) *testRegisteredJSONDocument {
+		return &testRegisteredJSONDocument{testJSONDocument{Value: v}}
+	})
+
+	// Values rebuilt from the zero type (as nested object attributes are) have no constructor of their own.
+	val, err := fwtypes.SmithyJSONType[*testRegisteredJSONDocument]{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, `{"test": "value"}`))
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	s, diags := val.(fwtypes.SmithyJSON[*testRegisteredJSONDocument]).ValueInterface()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := map[string]any{"test": "value"}
+	if diff := cmp.Diff(s.Value, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	val, err = fwtypes.SmithyJSONType[*testUnregisteredJSONDocument]{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, `{"test": "value"}`))
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if _, diags := val.(fwtypes.SmithyJSON[*testUnregisteredJSONDocument]).ValueInterface(); !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+}
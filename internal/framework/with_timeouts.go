@@ -9,12 +9,20 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
 // See https://developer.hashicorp.com/terraform/language/resources/syntax#operation-timeouts.
+// Default timeout values are scaled by the provider's timeout multiplier.
 type WithTimeouts struct {
 	defaultCreateTimeout, defaultReadTimeout, defaultUpdateTimeout, defaultDeleteTimeout time.Duration
+	timeoutMultiplier                                                                    float64
+}
+
+// SetTimeoutMultiplier sets the multiplier applied to the resource's default timeout values.
+func (w *WithTimeouts) SetTimeoutMultiplier(multiplier float64) {
+	w.timeoutMultiplier = multiplier
 }
 
 // SetDefaultCreateTimeout sets the resource's default Create timeout value.
@@ -37,9 +45,10 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 	w.defaultDeleteTimeout = timeout
 }
 
-// CreateTimeout returns any configured Create timeout value or the default value.
+// CreateTimeout returns any configured Create timeout value or the (scaled) default value.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
+	defaultTimeout := conns.ScaleTimeout(w.defaultCreateTimeout, w.timeoutMultiplier)
+	timeout, diags := timeouts.Create(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Create timeout", map[string]interface{}{
@@ -47,15 +56,16 @@ func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultCreateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
-// ReadTimeout returns any configured Read timeout value or the default value.
+// ReadTimeout returns any configured Read timeout value or the (scaled) default value.
 func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Read(ctx, w.defaultReadTimeout)
+	defaultTimeout := conns.ScaleTimeout(w.defaultReadTimeout, w.timeoutMultiplier)
+	timeout, diags := timeouts.Read(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Read timeout", map[string]interface{}{
@@ -63,15 +73,16 @@ func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value)
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultReadTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
-// UpdateTimeout returns any configured Update timeout value or the default value.
+// UpdateTimeout returns any configured Update timeout value or the (scaled) default value.
 func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Update(ctx, w.defaultUpdateTimeout)
+	defaultTimeout := conns.ScaleTimeout(w.defaultUpdateTimeout, w.timeoutMultiplier)
+	timeout, diags := timeouts.Update(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Update timeout", map[string]interface{}{
@@ -79,15 +90,16 @@ func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultUpdateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
-// DeleteTimeout returns any configured Delete timeout value or the default value.
+// DeleteTimeout returns any configured Delete timeout value or the (scaled) default value.
 func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Delete(ctx, w.defaultDeleteTimeout)
+	defaultTimeout := conns.ScaleTimeout(w.defaultDeleteTimeout, w.timeoutMultiplier)
+	timeout, diags := timeouts.Delete(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Delete timeout", map[string]interface{}{
@@ -95,7 +107,7 @@ func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultDeleteTimeout
+		return defaultTimeout
 	}
 
 	return timeout
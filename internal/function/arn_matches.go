@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	arnSectionCount = 6 // "arn", partition, service, region, account ID and resource.
+	arnPrefix       = "arn"
+)
+
+var _ function.Function = arnMatchesFunction{}
+
+func NewARNMatchesFunction() function.Function {
+	return &arnMatchesFunction{}
+}
+
+type arnMatchesFunction struct{}
+
+func (f arnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_matches"
+}
+
+func (f arnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_matches Function",
+		MarkdownDescription: "Returns whether an ARN matches a pattern. Each section of the pattern is matched " +
+			"separately, as IAM does. `*` matches any sequence of characters and `?` matches any single character.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN (Amazon Resource Name) pattern, which can contain `*` and `?` wildcards",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateARNPattern(pattern); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+	}
+	if _, err := arn.Parse(arg); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, arnMatches(pattern, arg)))
+}
+
+// validateARNPattern returns an error if the specified value is not an ARN pattern.
+func validateARNPattern(pattern string) error {
+	sections := strings.SplitN(pattern, ":", arnSectionCount)
+
+	if sections[0] != arnPrefix {
+		return errors.New("pattern must begin with \"arn:\"")
+	}
+	if len(sections) != arnSectionCount {
+		return errors.New("pattern must have 6 sections separated by \":\"")
+	}
+	if sections[1] == "" {
+		return errors.New("pattern partition must not be empty")
+	}
+	if sections[2] == "" {
+		return errors.New("pattern service must not be empty")
+	}
+
+	return nil
+}
+
+// arnMatches returns whether the specified ARN matches the ARN pattern.
+// Sections are matched separately, so wildcards never match across the ":" separating the
+// partition, service, Region, account ID and resource.
+// The resource section is matched as a whole, so wildcards in it can match ":" and "/".
+// An empty section in the pattern only matches an empty section, for example the Region of a global resource,
+// whereas "*" matches any value, including an empty one.
+func arnMatches(pattern, s string) bool {
+	patternSections := strings.SplitN(pattern, ":", arnSectionCount)
+	sections := strings.SplitN(s, ":", arnSectionCount)
+
+	if len(patternSections) != len(sections) {
+		return false
+	}
+
+	for i, patternSection := range patternSections {
+		if !wildcardMatch(patternSection, sections[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// wildcardMatch returns whether the specified value matches the pattern,
+// in which "*" matches any sequence of characters and "?" matches any single character.
+func wildcardMatch(pattern, s string) bool {
+	var (
+		p, v         int
+		star, starV  = -1, 0
+		patternRunes = []rune(pattern)
+		runes        = []rune(s)
+	)
+
+	for v < len(runes) {
+		switch {
+		case p < len(patternRunes) && (patternRunes[p] == '?' || patternRunes[p] == runes[v]):
+			p++
+			v++
+		case p < len(patternRunes) && patternRunes[p] == '*':
+			star, starV = p, v
+			p++
+		case star >= 0:
+			// Backtrack: let the last "*" match one more character.
+			p = star + 1
+			starV++
+			v = starV
+		default:
+			return false
+		}
+	}
+
+	for p < len(patternRunes) && patternRunes[p] == '*' {
+		p++
+	}
+
+	return p == len(patternRunes)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNMatchesFunction_match(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern  string
+		arn      string
+		expected string
+	}{
+		"exact": {
+			pattern:  "arn:aws:iam::444455556666:role/example",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			expected: "true",
+		},
+		"account wildcard": {
+			pattern:  "arn:aws:iam::*:role/*",
+			arn:      "arn:aws:iam::444455556666:role/path/example",
+			expected: "true",
+		},
+		"wrong account": {
+			pattern:  "arn:aws:iam::111122223333:role/*",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			expected: "false",
+		},
+		"wrong service": {
+			pattern:  "arn:aws:iam::*:*",
+			arn:      "arn:aws:s3:::example",
+			expected: "false",
+		},
+		"partition wildcard": {
+			pattern:  "arn:aws*:s3:::example-?",
+			arn:      "arn:aws-cn:s3:::example-a",
+			expected: "true",
+		},
+		"single character wildcard": {
+			pattern:  "arn:aws:s3:::example-?",
+			arn:      "arn:aws:s3:::example-ab",
+			expected: "false",
+		},
+		"empty region": {
+			pattern:  "arn:aws:ec2::*:*",
+			arn:      "arn:aws:ec2:us-west-2:444455556666:instance/i-1234567890abcdef0", //lintignore:AWSAT003
+			expected: "false",
+		},
+		"wildcard region": {
+			pattern:  "arn:aws:ec2:*:*:*",
+			arn:      "arn:aws:ec2:us-west-2:444455556666:instance/i-1234567890abcdef0", //lintignore:AWSAT003
+			expected: "true",
+		},
+		"resource wildcard spans separators": {
+			pattern:  "arn:aws:logs:*:*:log-group:*",
+			arn:      "arn:aws:logs:us-west-2:444455556666:log-group:/aws/lambda/example:*", //lintignore:AWSAT003
+			expected: "true",
+		},
+		"wildcard does not span sections": {
+			pattern:  "arn:aws:*:444455556666:*",
+			arn:      "arn:aws:sqs:us-west-2:444455556666:example", //lintignore:AWSAT003
+			expected: "false",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testARNMatchesFunctionConfig(testCase.pattern, testCase.arn),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("test", testCase.expected),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestARNMatchesFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchesFunctionConfig("arn:aws:*", "arn:aws:iam::444455556666:role/example"),
+				ExpectError: regexache.MustCompile(`pattern[\s\n]*must[\s\n]*have[\s\n]*6[\s\n]*sections`),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_invalidARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchesFunctionConfig("arn:aws:iam::*:role/*", "invalid"),
+				ExpectError: expectedErrorInvalidARN,
+			},
+		},
+	})
+}
+
+func testARNMatchesFunctionConfig(pattern, arn string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_matches(%[1]q, %[2]q)
+}
+`, pattern, arn)
+}
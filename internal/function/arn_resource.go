@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var arnResourceResultAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+}
+
+var _ function.Function = arnResourceFunction{}
+
+func NewARNResourceFunction() function.Function {
+	return &arnResourceFunction{}
+}
+
+type arnResourceFunction struct{}
+
+func (f arnResourceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_resource"
+}
+
+func (f arnResourceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_resource Function",
+		MarkdownDescription: "Splits the resource section of an ARN into the resource type and resource ID. " +
+			"Resource sections of the form `type/id` and `type:id` are supported. If the resource section " +
+			"has no type, the resource type is empty and the resource ID is the whole resource section.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to split the resource section of",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: arnResourceResultAttrTypes,
+		},
+	}
+}
+
+func (f arnResourceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := arn.Parse(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resourceType, resourceID := splitARNResource(parts.Resource)
+	value := map[string]attr.Value{
+		"type": types.StringValue(resourceType),
+		"id":   types.StringValue(resourceID),
+	}
+
+	result, d := types.ObjectValue(arnResourceResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// splitARNResource splits the resource section of an ARN into the resource type and resource ID
+// at the first "/" or ":", whichever comes first.
+// For example, "role/path/example" is split into "role" and "path/example"
+// and "log-group:/aws/lambda/example:*" into "log-group" and "/aws/lambda/example:*".
+func splitARNResource(resource string) (string, string) {
+	i := strings.IndexAny(resource, "/:")
+	if i < 0 {
+		return "", resource
+	}
+
+	return resource[:i], resource[i+1:]
+}
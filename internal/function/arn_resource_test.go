@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNResourceFunction_known(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn          string
+		expectedType string
+		expectedID   string
+	}{
+		"slash": {
+			arn:          "arn:aws:iam::444455556666:role/path/example",
+			expectedType: "role",
+			expectedID:   "path/example",
+		},
+		"colon": {
+			arn:          "arn:aws:logs:us-west-2:444455556666:log-group:/aws/lambda/example:*", //lintignore:AWSAT003
+			expectedType: "log-group",
+			expectedID:   "/aws/lambda/example:*",
+		},
+		"no type": {
+			arn:          "arn:aws:sqs:us-west-2:444455556666:example", //lintignore:AWSAT003
+			expectedType: "",
+			expectedID:   "example",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testARNResourceFunctionConfig(testCase.arn),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("type", testCase.expectedType),
+							resource.TestCheckOutput("id", testCase.expectedID),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestARNResourceFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNResourceFunctionConfig("invalid"),
+				ExpectError: expectedErrorInvalidARN,
+			},
+		},
+	})
+}
+
+func testARNResourceFunctionConfig(arn string) string {
+	return fmt.Sprintf(`
+output "type" {
+  value = provider::aws::arn_resource(%[1]q).type
+}
+
+output "id" {
+  value = provider::aws::arn_resource(%[1]q).id
+}
+`, arn)
+}
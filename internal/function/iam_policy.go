@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// IAM policy elements. See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements.html.
+const (
+	iamPolicyElementAction       = "Action"
+	iamPolicyElementCondition    = "Condition"
+	iamPolicyElementID           = "Id"
+	iamPolicyElementNotAction    = "NotAction"
+	iamPolicyElementNotPrincipal = "NotPrincipal"
+	iamPolicyElementNotResource  = "NotResource"
+	iamPolicyElementPrincipal    = "Principal"
+	iamPolicyElementResource     = "Resource"
+	iamPolicyElementSid          = "Sid"
+	iamPolicyElementStatement    = "Statement"
+	iamPolicyElementVersion      = "Version"
+)
+
+// parseIAMPolicy parses an IAM policy document.
+// A single statement that is not in an array is returned in an array.
+func parseIAMPolicy(policy string) (map[string]any, error) {
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("policy is invalid JSON: %w", err)
+	}
+
+	switch v := doc[iamPolicyElementStatement].(type) {
+	case nil:
+	case map[string]any:
+		doc[iamPolicyElementStatement] = []any{v}
+	case []any:
+		for i, v := range v {
+			if _, ok := v.(map[string]any); !ok {
+				return nil, fmt.Errorf("policy statement %d is not an object", i)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("policy %s is not an object or array", iamPolicyElementStatement)
+	}
+
+	return doc, nil
+}
+
+// canonicalIAMPolicy returns the canonical JSON form of an IAM policy document.
+// Within each statement, lists of values are sorted and de-duplicated and lists containing a single value are replaced by the value.
+// Object keys are sorted, except that Version is first as required by AWS.
+func canonicalIAMPolicy(doc map[string]any) (string, error) {
+	if statements, ok := doc[iamPolicyElementStatement].([]any); ok {
+		for _, v := range statements {
+			canonicalIAMPolicyStatement(v.(map[string]any))
+		}
+	}
+
+	// encoding/json sorts object keys, so only the top-level keys need to be ordered explicitly.
+	keys := make([]string, 0, len(doc))
+	for k := range doc {
+		if k != iamPolicyElementVersion {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	if _, ok := doc[iamPolicyElementVersion]; ok {
+		keys = slices.Insert(keys, 0, iamPolicyElementVersion)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(k)
+		if err != nil {
+			return "", err
+		}
+		value, err := json.Marshal(doc[k])
+		if err != nil {
+			return "", err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.String(), nil
+}
+
+func canonicalIAMPolicyStatement(statement map[string]any) {
+	for _, k := range []string{iamPolicyElementAction, iamPolicyElementNotAction, iamPolicyElementResource, iamPolicyElementNotResource} {
+		if v, ok := statement[k]; ok {
+			statement[k] = canonicalIAMPolicyValues(v)
+		}
+	}
+
+	for _, k := range []string{iamPolicyElementPrincipal, iamPolicyElementNotPrincipal} {
+		if m, ok := statement[k].(map[string]any); ok {
+			for k, v := range m {
+				m[k] = canonicalIAMPolicyValues(v)
+			}
+		}
+	}
+
+	if m, ok := statement[iamPolicyElementCondition].(map[string]any); ok {
+		for _, v := range m {
+			if m, ok := v.(map[string]any); ok {
+				for k, v := range m {
+					m[k] = canonicalIAMPolicyValues(v)
+				}
+			}
+		}
+	}
+}
+
+// canonicalIAMPolicyValues sorts and de-duplicates a list of string values.
+// A list containing a single value is replaced by the value. Any other value is returned unchanged.
+func canonicalIAMPolicyValues(v any) any {
+	list, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			return list
+		}
+		values = append(values, s)
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	result := make([]any, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+
+	return result
+}
+
+// mergeIAMPolicies merges IAM policy documents in order.
+// Statements with the same Sid as a statement in an earlier document replace that statement, in its position.
+// Statements without a Sid are appended. The latest Version and the last non-empty Id are used.
+// Empty policy documents are ignored. Sids must be unique within each policy document.
+func mergeIAMPolicies(policies []string) (map[string]any, error) {
+	var (
+		id, version string
+		statements  []any
+	)
+
+	for i, policy := range policies {
+		if strings.TrimSpace(policy) == "" {
+			continue
+		}
+
+		doc, err := parseIAMPolicy(policy)
+		if err != nil {
+			return nil, fmt.Errorf("merging policy %d: %w", i, err)
+		}
+
+		if v, ok := doc[iamPolicyElementID].(string); ok && v != "" {
+			id = v
+		}
+
+		if v, ok := doc[iamPolicyElementVersion].(string); ok && v > version {
+			version = v
+		}
+
+		sids := make(map[string]struct{})
+		v, _ := doc[iamPolicyElementStatement].([]any)
+		for _, statement := range v {
+			if sid, ok := statement.(map[string]any)[iamPolicyElementSid].(string); ok && sid != "" {
+				if _, ok := sids[sid]; ok {
+					return nil, fmt.Errorf("merging policy %d: duplicate %s (%s)", i, iamPolicyElementSid, sid)
+				}
+				sids[sid] = struct{}{}
+
+				if i := slices.IndexFunc(statements, func(v any) bool {
+					return v.(map[string]any)[iamPolicyElementSid] == sid
+				}); i >= 0 {
+					statements[i] = statement
+					continue
+				}
+			}
+
+			statements = append(statements, statement)
+		}
+	}
+
+	doc := make(map[string]any)
+	if id != "" {
+		doc[iamPolicyElementID] = id
+	}
+	if len(statements) > 0 {
+		doc[iamPolicyElementStatement] = statements
+	}
+	if version != "" {
+		doc[iamPolicyElementVersion] = version
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Subnet sizes supported by Amazon VPC.
+	subnetIPv4MaxPrefixLength = 28
+	subnetIPv6MaxPrefixLength = 64
+
+	// Each tier reserves room for this many Availability Zones, the most in any Region rounded up to a power of two,
+	// so that appending Availability Zones doesn't change existing CIDR blocks.
+	subnetTierAvailabilityZonesBits = 3
+	subnetTierMaxAvailabilityZones  = 1 << subnetTierAvailabilityZonesBits
+)
+
+var subnetTierAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"prefix_length": types.Int64Type,
+}
+
+type subnetTier struct {
+	Name         string `tfsdk:"name"`
+	PrefixLength int64  `tfsdk:"prefix_length"`
+}
+
+var _ function.Function = subnetCIDRsFunction{}
+
+func NewSubnetCIDRsFunction() function.Function {
+	return &subnetCIDRsFunction{}
+}
+
+type subnetCIDRsFunction struct{}
+
+func (f subnetCIDRsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_cidrs"
+}
+
+func (f subnetCIDRsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "subnet_cidrs Function",
+		MarkdownDescription: "Plans non-overlapping subnet CIDR blocks for each tier in each Availability Zone " +
+			"from a VPC CIDR block. Returns a map of `<tier>/<availability zone>` to CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "tiers",
+				MarkdownDescription: "Subnet tiers, each an object with `name` and `prefix_length` attributes",
+				ElementType: types.ObjectType{
+					AttrTypes: subnetTierAttrTypes,
+				},
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zones to plan a subnet in for each tier",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f subnetCIDRsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		vpcCIDR string
+		tiers   []subnetTier
+		azs     []string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &tiers, &azs))
+	if resp.Error != nil {
+		return
+	}
+
+	maxPrefixLength := subnetIPv4MaxPrefixLength
+	if strings.Contains(vpcCIDR, ":") {
+		maxPrefixLength = subnetIPv6MaxPrefixLength
+
+		if err := verify.ValidateIPv6CIDRBlock(vpcCIDR); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		}
+	} else {
+		if err := verify.ValidateIPv4CIDRBlock(vpcCIDR); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateSubnetTiers(vpcCIDR, tiers, maxPrefixLength); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+	}
+	if err := validateSubnetAvailabilityZones(azs); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := planSubnetCIDRs(vpcCIDR, tiers, azs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func validateSubnetTiers(vpcCIDR string, tiers []subnetTier, maxPrefixLength int) error {
+	_, ipnet, err := net.ParseCIDR(vpcCIDR)
+	if err != nil {
+		return err
+	}
+	minPrefixLength, _ := ipnet.Mask.Size()
+
+	if len(tiers) == 0 {
+		return fmt.Errorf("at least one tier is required")
+	}
+
+	seen := make(map[string]struct{}, len(tiers))
+	for _, tier := range tiers {
+		if tier.Name == "" {
+			return fmt.Errorf("tier name must not be empty")
+		}
+		if strings.Contains(tier.Name, "/") {
+			return fmt.Errorf("tier name (%s) must not contain \"/\"", tier.Name)
+		}
+		if _, ok := seen[tier.Name]; ok {
+			return fmt.Errorf("duplicate tier name (%s)", tier.Name)
+		}
+		seen[tier.Name] = struct{}{}
+
+		if tier.PrefixLength < int64(minPrefixLength) || tier.PrefixLength > int64(maxPrefixLength) {
+			return fmt.Errorf("tier (%s) prefix_length (%d) must be between %d and %d", tier.Name, tier.PrefixLength, minPrefixLength, maxPrefixLength)
+		}
+	}
+
+	return nil
+}
+
+func validateSubnetAvailabilityZones(azs []string) error {
+	if len(azs) == 0 {
+		return fmt.Errorf("at least one Availability Zone is required")
+	}
+
+	if len(azs) > subnetTierMaxAvailabilityZones {
+		return fmt.Errorf("at most %d Availability Zones are supported", subnetTierMaxAvailabilityZones)
+	}
+
+	seen := make(map[string]struct{}, len(azs))
+	for _, az := range azs {
+		if az == "" {
+			return fmt.Errorf("availability_zones must not contain empty values")
+		}
+		if _, ok := seen[az]; ok {
+			return fmt.Errorf("duplicate Availability Zone (%s)", az)
+		}
+		seen[az] = struct{}{}
+	}
+
+	return nil
+}
+
+// planSubnetCIDRs allocates a CIDR block for each tier in each Availability Zone
+// and returns a map of "<tier>/<availability zone>" to CIDR block.
+// Each tier is allocated, in order, a block with room for subnetTierMaxAvailabilityZones subnets,
+// and each Availability Zone's subnet is at the Availability Zone's index within its tier's block.
+// Appending tiers or Availability Zones therefore never changes existing CIDR blocks.
+func planSubnetCIDRs(vpcCIDR string, tiers []subnetTier, azs []string) (map[string]string, error) {
+	_, ipnet, err := net.ParseCIDR(vpcCIDR)
+	if err != nil {
+		return nil, err
+	}
+	vpcPrefixLength, _ := ipnet.Mask.Size()
+
+	prefixLengths := make([]int, 0, len(tiers))
+	for _, tier := range tiers {
+		prefixLength := int(tier.PrefixLength) - subnetTierAvailabilityZonesBits
+		if prefixLength < vpcPrefixLength {
+			return nil, fmt.Errorf("planning subnets: %q has no room for %d /%d CIDR blocks for tier (%s)", vpcCIDR, subnetTierMaxAvailabilityZones, tier.PrefixLength, tier.Name)
+		}
+		prefixLengths = append(prefixLengths, prefixLength)
+	}
+
+	tierCIDRs, err := itypes.AllocateCIDRBlocks(vpcCIDR, prefixLengths)
+	if err != nil {
+		return nil, fmt.Errorf("planning subnets: %w", err)
+	}
+
+	result := make(map[string]string, len(tiers)*len(azs))
+	for i, tier := range tiers {
+		prefixLengths := make([]int, len(azs))
+		for j := range azs {
+			prefixLengths[j] = int(tier.PrefixLength)
+		}
+
+		cidrs, err := itypes.AllocateCIDRBlocks(tierCIDRs[i], prefixLengths)
+		if err != nil {
+			return nil, fmt.Errorf("planning subnets for tier (%s): %w", tier.Name, err)
+		}
+
+		for j, az := range azs {
+			result[tier.Name+"/"+az] = cidrs[j]
+		}
+	}
+
+	return result, nil
+}
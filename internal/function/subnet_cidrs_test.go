@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestSubnetCIDRsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "public", prefix_length = 24 }, { name = "private", prefix_length = 20 }]`
+	azs := `["us-west-2a", "us-west-2b"]` //lintignore:AWSAT003
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetCIDRsFunctionConfig("10.0.0.0/16", tiers, azs),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "4"),
+					resource.TestCheckOutput("public_a", "10.0.0.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.1.0/24"),
+					resource.TestCheckOutput("private_a", "10.0.128.0/20"),
+					resource.TestCheckOutput("private_b", "10.0.144.0/20"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetCIDRsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "public", prefix_length = 64 }, { name = "private", prefix_length = 64 }]`
+	azs := `["us-west-2a", "us-west-2b"]` //lintignore:AWSAT003
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetCIDRsFunctionConfig("2600:1f14:abc:de00::/56", tiers, azs),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "4"),
+					resource.TestCheckOutput("public_a", "2600:1f14:abc:de00::/64"),
+					resource.TestCheckOutput("public_b", "2600:1f14:abc:de01::/64"),
+					resource.TestCheckOutput("private_a", "2600:1f14:abc:de08::/64"),
+					resource.TestCheckOutput("private_b", "2600:1f14:abc:de09::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetCIDRsFunction_append(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetCIDRsFunctionConfig("10.0.0.0/16",
+					`[{ name = "public", prefix_length = 24 }, { name = "private", prefix_length = 22 }]`,
+					`["us-west-2a", "us-west-2b"]`), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "4"),
+					resource.TestCheckOutput("public_a", "10.0.0.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.1.0/24"),
+					resource.TestCheckOutput("private_a", "10.0.32.0/22"),
+					resource.TestCheckOutput("private_b", "10.0.36.0/22"),
+				),
+			},
+			{
+				// Appending an Availability Zone and a tier doesn't change existing CIDR blocks.
+				Config: testSubnetCIDRsFunctionConfig("10.0.0.0/16",
+					`[{ name = "public", prefix_length = 24 }, { name = "private", prefix_length = 22 }, { name = "database", prefix_length = 24 }]`,
+					`["us-west-2a", "us-west-2b", "us-west-2c"]`), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "9"),
+					resource.TestCheckOutput("public_a", "10.0.0.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.1.0/24"),
+					resource.TestCheckOutput("private_a", "10.0.32.0/22"),
+					resource.TestCheckOutput("private_b", "10.0.36.0/22"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetCIDRsFunction_tooManyAvailabilityZones(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "public", prefix_length = 24 }]`
+	azs := `["us-east-1a", "us-east-1b", "us-east-1c", "us-east-1d", "us-east-1e", "us-east-1f", "us-east-1g", "us-east-1h", "us-east-1i"]` //lintignore:AWSAT003
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetCIDRsFunctionConfig("10.0.0.0/16", tiers, azs),
+				ExpectError: regexache.MustCompile(`at[\s\n]*most[\s\n]*8`),
+			},
+		},
+	})
+}
+
+func TestSubnetCIDRsFunction_noRoom(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "public", prefix_length = 25 }, { name = "private", prefix_length = 25 }]`
+	azs := `["us-west-2a", "us-west-2b"]` //lintignore:AWSAT003
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetCIDRsFunctionConfig("10.0.0.0/24", tiers, azs),
+				ExpectError: regexache.MustCompile(`has[\s\n]*no[\s\n]*room`),
+			},
+		},
+	})
+}
+
+func TestSubnetCIDRsFunction_invalidVPCCIDR(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "public", prefix_length = 24 }]`
+	azs := `["us-west-2a"]` //lintignore:AWSAT003
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetCIDRsFunctionConfig("10.0.0.1/16", tiers, azs),
+				ExpectError: regexache.MustCompile(`did[\s\n]*you[\s\n]*mean`),
+			},
+		},
+	})
+}
+
+func TestSubnetCIDRsFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+	tiers := `[{ name = "public", prefix_length = 30 }]`
+	azs := `["us-west-2a"]` //lintignore:AWSAT003
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetCIDRsFunctionConfig("10.0.0.0/16", tiers, azs),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*between[\s\n]*16[\s\n]*and[\s\n]*28`),
+			},
+		},
+	})
+}
+
+func testSubnetCIDRsFunctionConfig(vpcCIDR, tiers, azs string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::subnet_cidrs(%[1]q, %[2]s, %[3]s)
+  azs     = %[3]s
+}
+
+output "count" {
+  value = length(local.subnets)
+}
+
+output "public_a" {
+  value = try(local.subnets["public/${local.azs[0]}"], "")
+}
+
+output "public_b" {
+  value = try(local.subnets["public/${local.azs[1]}"], "")
+}
+
+output "private_a" {
+  value = try(local.subnets["private/${local.azs[0]}"], "")
+}
+
+output "private_b" {
+  value = try(local.subnets["private/${local.azs[1]}"], "")
+}
+`, vpcCIDR, tiers, azs)
+}
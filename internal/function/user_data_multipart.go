@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// userDataMaxSize is the maximum size of EC2 user data, in raw form before it is base64-encoded.
+	userDataMaxSize = 16 * 1024
+
+	// userDataMultipartBoundary is a fixed boundary so that the same parts always produce the same user data.
+	userDataMultipartBoundary = "MIMEBOUNDARY"
+)
+
+var userDataPartAttrTypes = map[string]attr.Type{
+	"content_type": types.StringType,
+	"filename":     types.StringType,
+	"content":      types.StringType,
+}
+
+type userDataPart struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Filename    types.String `tfsdk:"filename"`
+	Content     types.String `tfsdk:"content"`
+}
+
+var _ function.Function = userDataMultipartFunction{}
+
+func NewUserDataMultipartFunction() function.Function {
+	return &userDataMultipartFunction{}
+}
+
+type userDataMultipartFunction struct{}
+
+func (f userDataMultipartFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user_data_multipart"
+}
+
+func (f userDataMultipartFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "user_data_multipart Function",
+		MarkdownDescription: "Builds base64-encoded MIME multipart EC2 user data, as used by cloud-init, from a list " +
+			"of parts. The user data is optionally gzip-compressed and must not exceed the EC2 limit of 16 KB.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "parts",
+				MarkdownDescription: "Parts of the user data, each an object with `content_type`, `filename` and `content` attributes",
+				ElementType: types.ObjectType{
+					AttrTypes: userDataPartAttrTypes,
+				},
+			},
+			function.BoolParameter{
+				Name:                "gzip",
+				MarkdownDescription: "Whether to gzip-compress the user data",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f userDataMultipartFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		parts    []userDataPart
+		compress bool
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &parts, &compress))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateUserDataParts(parts); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := buildUserDataMultipart(parts, compress)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func validateUserDataParts(parts []userDataPart) error {
+	if len(parts) == 0 {
+		return fmt.Errorf("at least one part is required")
+	}
+
+	for i, part := range parts {
+		if part.ContentType.IsNull() || part.ContentType.IsUnknown() || part.ContentType.ValueString() == "" {
+			return fmt.Errorf("part %d: content_type must not be empty", i)
+		}
+		if strings.ContainsAny(part.ContentType.ValueString(), "\r\n") {
+			return fmt.Errorf("part %d: content_type must not contain line breaks", i)
+		}
+		if strings.ContainsAny(part.Filename.ValueString(), "\r\n\"") {
+			return fmt.Errorf("part %d: filename must not contain line breaks or quotes", i)
+		}
+		// A part containing the boundary would end early.
+		if strings.Contains(part.Content.ValueString(), "--"+userDataMultipartBoundary) {
+			return fmt.Errorf("part %d: content must not contain the MIME boundary %q", i, "--"+userDataMultipartBoundary)
+		}
+	}
+
+	return nil
+}
+
+// transferEncoding returns the MIME content transfer encoding of content that is sent unencoded.
+func transferEncoding(content string) string {
+	for i := 0; i < len(content); i++ {
+		if content[i] >= utf8.RuneSelf {
+			return "8bit"
+		}
+	}
+
+	return "7bit"
+}
+
+// buildUserDataMultipart returns the specified parts as a base64-encoded MIME multipart document.
+// An error is returned if the document, after any compression, exceeds the EC2 user data size limit.
+func buildUserDataMultipart(parts []userDataPart, compress bool) (string, error) {
+	var body bytes.Buffer
+
+	w := multipart.NewWriter(&body)
+	if err := w.SetBoundary(userDataMultipartBoundary); err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(&body, "Content-Type: multipart/mixed; boundary=\"%s\"\r\n", userDataMultipartBoundary)
+	fmt.Fprint(&body, "MIME-Version: 1.0\r\n\r\n")
+
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType.ValueString())
+		header.Set("Content-Transfer-Encoding", transferEncoding(part.Content.ValueString()))
+		header.Set("Mime-Version", "1.0")
+		if v := part.Filename.ValueString(); v != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", v))
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := pw.Write([]byte(part.Content.ValueString())); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	data := body.Bytes()
+
+	if compress {
+		var b bytes.Buffer
+
+		gw := gzip.NewWriter(&b)
+		if _, err := gw.Write(data); err != nil {
+			return "", err
+		}
+		if err := gw.Close(); err != nil {
+			return "", err
+		}
+
+		data = b.Bytes()
+	}
+
+	if n := len(data); n > userDataMaxSize {
+		if compress {
+			return "", fmt.Errorf("user data is %d bytes after gzip compression, which exceeds the EC2 user data limit of %d bytes", n, userDataMaxSize)
+		}
+		return "", fmt.Errorf("user data is %d bytes, which exceeds the EC2 user data limit of %d bytes; consider enabling gzip compression", n, userDataMaxSize)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestUserDataMultipartFunction_basic(t *testing.T) {
+	t.Parallel()
+	// Content-Type: multipart/mixed; boundary="MIMEBOUNDARY"
+	// MIME-Version: 1.0
+	//
+	// --MIMEBOUNDARY
+	// Content-Disposition: attachment; filename="hello.sh"
+	// Content-Transfer-Encoding: 7bit
+	// Content-Type: text/x-shellscript
+	// Mime-Version: 1.0
+	//
+	// #!/bin/sh
+	// echo hello
+	//
+	// --MIMEBOUNDARY--
+	expected := "Q29udGVudC1UeXBlOiBtdWx0aXBhcnQvbWl4ZWQ7IGJvdW5kYXJ5PSJNSU1FQk9VTkRBUlkiDQpNSU1FLVZlcnNpb246IDEuMA0KDQotLU1JTUVCT1VOREFSWQ0KQ29udGVudC1EaXNwb3NpdGlvbjogYXR0YWNobWVudDsgZmlsZW5hbWU9ImhlbGxvLnNoIg0KQ29udGVudC1UcmFuc2Zlci1FbmNvZGluZzogN2JpdA0KQ29udGVudC1UeXBlOiB0ZXh0L3gtc2hlbGxzY3JpcHQNCk1pbWUtVmVyc2lvbjogMS4wDQoNCiMhL2Jpbi9zaAplY2hvIGhlbGxvCg0KLS1NSU1FQk9VTkRBUlktLQ0K"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUserDataMultipartFunctionConfig("text/x-shellscript", "hello.sh", "#!/bin/sh\necho hello\n", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestUserDataMultipartFunction_gzip(t *testing.T) {
+	t.Parallel()
+	content := strings.Repeat("echo hello\n", 2000)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUserDataMultipartFunctionConfig("text/x-shellscript", "hello.sh", content, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Base64-encoded gzip data starts with the gzip magic number.
+					resource.TestMatchOutput("test", regexache.MustCompile(`^H4sI`)),
+				),
+			},
+		},
+	})
+}
+
+func TestUserDataMultipartFunction_tooLarge(t *testing.T) {
+	t.Parallel()
+	content := strings.Repeat("echo hello\n", 2000)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testUserDataMultipartFunctionConfig("text/x-shellscript", "hello.sh", content, false),
+				ExpectError: regexache.MustCompile(`exceeds[\s\n]*the[\s\n]*EC2[\s\n]*user[\s\n]*data[\s\n]*limit`),
+			},
+		},
+	})
+}
+
+func TestUserDataMultipartFunction_emptyContentType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testUserDataMultipartFunctionConfig("", "hello.sh", "#!/bin/sh\necho hello\n", false),
+				ExpectError: regexache.MustCompile(`content_type[\s\n]*must[\s\n]*not[\s\n]*be[\s\n]*empty`),
+			},
+		},
+	})
+}
+
+func TestUserDataMultipartFunction_nonASCII(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUserDataMultipartFunctionConfig_decoded("text/x-shellscript", "hello.sh", "#!/bin/sh\necho héllo\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchOutput("test", regexache.MustCompile(`Content-Transfer-Encoding: 8bit`)),
+				),
+			},
+		},
+	})
+}
+
+func TestUserDataMultipartFunction_boundaryInContent(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testUserDataMultipartFunctionConfig("text/x-shellscript", "hello.sh", "#!/bin/sh\necho --MIMEBOUNDARY--\n", false),
+				ExpectError: regexache.MustCompile(`content[\s\n]*must[\s\n]*not[\s\n]*contain[\s\n]*the[\s\n]*MIME[\s\n]*boundary`),
+			},
+		},
+	})
+}
+
+func testUserDataMultipartFunctionConfig(contentType, filename, content string, gzip bool) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::user_data_multipart([{
+    content_type = %[1]q
+    filename     = %[2]q
+    content      = %[3]q
+  }], %[4]t)
+}
+`, contentType, filename, content, gzip)
+}
+
+func testUserDataMultipartFunctionConfig_decoded(contentType, filename, content string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = base64decode(provider::aws::user_data_multipart([{
+    content_type = %[1]q
+    filename     = %[2]q
+    content      = %[3]q
+  }], false))
+}
+`, contentType, filename, content)
+}
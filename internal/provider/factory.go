@@ -24,7 +24,15 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return &preventDestroyProviderServer{
+				ProviderServer: &tagPolicyProviderServer{
+					ProviderServer: primary.GRPCProvider(),
+					provider:       primary,
+				},
+				provider: primary,
+			}
+		},
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
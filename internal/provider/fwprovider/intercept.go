@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -200,13 +201,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// region is true if a `region` attribute is injected into the data source's schema.
+	region bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, region bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
@@ -218,14 +222,23 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.region {
+		response.Schema.Attributes[names.AttrRegion] = dataSourceRegionAttribute()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		if w.region {
+			return w.readWithRegion(ctx, request, response)
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetPhase(ctx, conns.PhaseRead, "")
 	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -255,13 +268,16 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// region is true if a `region` attribute is injected into the resource's schema.
+	region bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, region bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
@@ -273,59 +289,109 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.region {
+		response.Schema.Attributes[names.AttrRegion] = resourceRegionAttribute()
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.region {
+			return w.createWithRegion(ctx, request, response)
+		}
+
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetPhase(ctx, conns.PhaseCreate, "")
 	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.region {
+			return w.readWithRegion(ctx, request, response)
+		}
+
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetPhase(ctx, conns.PhaseRead, resourceIDFromState(ctx, request.State))
 	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.region {
+			return w.updateWithRegion(ctx, request, response)
+		}
+
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetPhase(ctx, conns.PhaseUpdate, resourceIDFromState(ctx, request.State))
 	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.region {
+			return w.deleteWithRegion(ctx, request, response)
+		}
+
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetPhase(ctx, conns.PhaseDelete, resourceIDFromState(ctx, request.State))
 	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
+// resourceIDFromState returns the value of any top-level "id" attribute in the specified state.
+func resourceIDFromState(ctx context.Context, state tfsdk.State) string {
+	if state.Raw.IsNull() {
+		return ""
+	}
+
+	var id fwtypes.String
+	if diags := state.GetAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() {
+		return ""
+	}
+
+	return id.ValueString()
+}
+
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
+
+	// Scale the resource's default timeouts by the provider's timeout multiplier.
+	if v, ok := w.inner.(interface{ SetTimeoutMultiplier(float64) }); ok && w.meta != nil {
+		v.SetTimeoutMultiplier(w.meta.TimeoutMultiplier)
+	}
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		conns.SetPhase(ctx, conns.PhaseImport, request.ID)
+		if w.region {
+			w.importStateWithRegion(ctx, v, request, response)
+
+			return
+		}
+
 		v.ImportState(ctx, request, response)
 
 		return
@@ -338,10 +404,20 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetPhase(ctx, conns.PhasePlan, resourceIDFromState(ctx, request.State))
+
+	if w.region {
+		w.modifyPlanWithRegion(ctx, request, response)
+	} else if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		v.ModifyPlan(ctx, request, response)
 	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.preventDestroy(ctx, request, response)
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -356,6 +432,12 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.region {
+			w.validateConfigWithRegion(ctx, v, request, response)
+
+			return
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -363,6 +445,9 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.region {
+			return w.upgradeStateWithRegion(ctx, v.UpgradeState(ctx))
+		}
 
 		return v.UpgradeState(ctx)
 	}
@@ -373,6 +458,9 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.region {
+			return w.moveStateWithRegion(ctx, v.MoveState(ctx))
+		}
 
 		return v.MoveState(ctx)
 	}
@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// preventDestroyResourceInterceptor refuses to delete resources matching the provider's `prevent_destroy` rules.
+type preventDestroyResourceInterceptor struct {
+	typeName string
+}
+
+func (r preventDestroyResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r preventDestroyResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r preventDestroyResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r preventDestroyResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || meta.PreventDestroyConfig == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if rule, ok := meta.PreventDestroyConfig.Match(r.typeName, stringMapFromValue(request.State.Raw, names.AttrTagsAll)); ok {
+			diags.AddError("Resource destruction prevented", conns.PreventDestroyError(r.typeName, stringFromValue(request.State.Raw, names.AttrID), rule).Error())
+		}
+	}
+
+	return ctx, diags
+}
+
+// preventDestroy fails the plan if it would destroy or replace a resource matching the provider's `prevent_destroy` rules.
+func (w *wrappedResource) preventDestroy(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if w.meta == nil || w.meta.PreventDestroyConfig == nil {
+		return
+	}
+
+	// Resource creation.
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	metadataResponse := resource.MetadataResponse{}
+	w.inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+	typeName := metadataResponse.TypeName
+
+	rule, ok := w.meta.PreventDestroyConfig.Match(typeName, stringMapFromValue(request.State.Raw, names.AttrTagsAll))
+	if !ok {
+		return
+	}
+
+	// Resource update, which may require replacement.
+	if !request.Plan.Raw.IsNull() && len(response.RequiresReplace) == 0 {
+		paths, diags := requiresReplace(ctx, request, response)
+		response.Diagnostics.Append(diags...)
+		if diags.HasError() || len(paths) == 0 {
+			return
+		}
+	}
+
+	response.Diagnostics.AddError("Resource destruction prevented", conns.PreventDestroyError(typeName, stringFromValue(request.State.Raw, names.AttrID), rule).Error())
+}
+
+// requiresReplace returns the paths of any top-level attributes or blocks whose plan modifiers require replacement of the resource.
+// Attribute-level replacement is not visible in a resource's ModifyPlan, so the plan modifiers are run again here.
+func requiresReplace(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var paths path.Paths
+
+	var stateAttrs map[string]tftypes.Value
+	if err := request.State.Raw.As(&stateAttrs); err != nil {
+		diags.AddError("Converting state", err.Error())
+		return nil, diags
+	}
+
+	var planAttrs map[string]tftypes.Value
+	if err := response.Plan.Raw.As(&planAttrs); err != nil {
+		diags.AddError("Converting plan", err.Error())
+		return nil, diags
+	}
+
+	var configAttrs map[string]tftypes.Value
+	if err := request.Config.Raw.As(&configAttrs); err != nil {
+		diags.AddError("Converting config", err.Error())
+		return nil, diags
+	}
+
+	for name, planValue := range planAttrs {
+		stateValue := stateAttrs[name]
+		if planValue.Equal(stateValue) {
+			continue
+		}
+
+		p := path.Root(name)
+		typ, d := request.Plan.Schema.TypeAtPath(ctx, p)
+		diags.Append(d...)
+		if d.HasError() {
+			continue
+		}
+
+		sa, ok := attributeOrBlock(request, name)
+		if !ok {
+			continue
+		}
+
+		config, err := typ.ValueFromTerraform(ctx, configAttrs[name])
+		if err != nil {
+			continue
+		}
+		plan, err := typ.ValueFromTerraform(ctx, planValue)
+		if err != nil {
+			continue
+		}
+		state, err := typ.ValueFromTerraform(ctx, stateValue)
+		if err != nil {
+			continue
+		}
+
+		if replace, d := runRequiresReplacePlanModifiers(ctx, sa, p, request, response, config, plan, state); replace {
+			paths = append(paths, p)
+		} else {
+			diags.Append(d...)
+		}
+	}
+
+	return paths, diags
+}
+
+// attributeOrBlock returns the schema attribute or block with the specified name.
+func attributeOrBlock(request resource.ModifyPlanRequest, name string) (any, bool) {
+	if v, ok := request.Plan.Schema.GetAttributes()[name]; ok {
+		return v, true
+	}
+
+	if v, ok := request.Plan.Schema.GetBlocks()[name]; ok {
+		return v, true
+	}
+
+	return nil, false
+}
+
+// runRequiresReplacePlanModifiers runs the plan modifiers of the specified attribute or block and
+// returns whether any of them require replacement of the resource.
+func runRequiresReplacePlanModifiers(ctx context.Context, sa any, p path.Path, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, config, plan, state attr.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch sa := sa.(type) {
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		c, d1 := config.(basetypes.StringValuable).ToStringValue(ctx)
+		p1, d2 := plan.(basetypes.StringValuable).ToStringValue(ctx)
+		s, d3 := state.(basetypes.StringValuable).ToStringValue(ctx)
+		diags.Append(d1...)
+		diags.Append(d2...)
+		diags.Append(d3...)
+		for _, m := range sa.StringPlanModifiers() {
+			req := planmodifier.StringRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: c, Plan: response.Plan, PlanValue: p1, State: request.State, StateValue: s}
+			resp := planmodifier.StringResponse{PlanValue: p1}
+			m.PlanModifyString(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true, diags
+			}
+		}
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		c, d1 := config.(basetypes.BoolValuable).ToBoolValue(ctx)
+		p1, d2 := plan.(basetypes.BoolValuable).ToBoolValue(ctx)
+		s, d3 := state.(basetypes.BoolValuable).ToBoolValue(ctx)
+		diags.Append(d1...)
+		diags.Append(d2...)
+		diags.Append(d3...)
+		for _, m := range sa.BoolPlanModifiers() {
+			req := planmodifier.BoolRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: c, Plan: response.Plan, PlanValue: p1, State: request.State, StateValue: s}
+			resp := planmodifier.BoolResponse{PlanValue: p1}
+			m.PlanModifyBool(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true, diags
+			}
+		}
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		c, d1 := config.(basetypes.Int64Valuable).ToInt64Value(ctx)
+		p1, d2 := plan.(basetypes.Int64Valuable).ToInt64Value(ctx)
+		s, d3 := state.(basetypes.Int64Valuable).ToInt64Value(ctx)
+		diags.Append(d1...)
+		diags.Append(d2...)
+		diags.Append(d3...)
+		for _, m := range sa.Int64PlanModifiers() {
+			req := planmodifier.Int64Request{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: c, Plan: response.Plan, PlanValue: p1, State: request.State, StateValue: s}
+			resp := planmodifier.Int64Response{PlanValue: p1}
+			m.PlanModifyInt64(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true, diags
+			}
+		}
+	case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+		c, d1 := config.(basetypes.Float64Valuable).ToFloat64Value(ctx)
+		p1, d2 := plan.(basetypes.Float64Valuable).ToFloat64Value(ctx)
+		s, d3 := state.(basetypes.Float64Valuable).ToFloat64Value(ctx)
+		diags.Append(d1...)
+		diags.Append(d2...)
+		diags.Append(d3...)
+		for _, m := range sa.Float64PlanModifiers() {
+			req := planmodifier.Float64Request{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: c, Plan: response.Plan, PlanValue: p1, State: request.State, StateValue: s}
+			resp := planmodifier.Float64Response{PlanValue: p1}
+			m.PlanModifyFloat64(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true, diags
+			}
+		}
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		c, d1 := config.(basetypes.ListValuable).ToListValue(ctx)
+		p1, d2 := plan.(basetypes.ListValuable).ToListValue(ctx)
+		s, d3 := state.(basetypes.ListValuable).ToListValue(ctx)
+		diags.Append(d1...)
+		diags.Append(d2...)
+		diags.Append(d3...)
+		for _, m := range sa.ListPlanModifiers() {
+			req := planmodifier.ListRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: c, Plan: response.Plan, PlanValue: p1, State: request.State, StateValue: s}
+			resp := planmodifier.ListResponse{PlanValue: p1}
+			m.PlanModifyList(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true, diags
+			}
+		}
+	case interface{ SetPlanModifiers() []planmodifier.Set }:
+		c, d1 := config.(basetypes.SetValuable).ToSetValue(ctx)
+		p1, d2 := plan.(basetypes.SetValuable).ToSetValue(ctx)
+		s, d3 := state.(basetypes.SetValuable).ToSetValue(ctx)
+		diags.Append(d1...)
+		diags.Append(d2...)
+		diags.Append(d3...)
+		for _, m := range sa.SetPlanModifiers() {
+			req := planmodifier.SetRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: c, Plan: response.Plan, PlanValue: p1, State: request.State, StateValue: s}
+			resp := planmodifier.SetResponse{PlanValue: p1}
+			m.PlanModifySet(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true, diags
+			}
+		}
+	case interface{ MapPlanModifiers() []planmodifier.Map }:
+		c, d1 := config.(basetypes.MapValuable).ToMapValue(ctx)
+		p1, d2 := plan.(basetypes.MapValuable).ToMapValue(ctx)
+		s, d3 := state.(basetypes.MapValuable).ToMapValue(ctx)
+		diags.Append(d1...)
+		diags.Append(d2...)
+		diags.Append(d3...)
+		for _, m := range sa.MapPlanModifiers() {
+			req := planmodifier.MapRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: c, Plan: response.Plan, PlanValue: p1, State: request.State, StateValue: s}
+			resp := planmodifier.MapResponse{PlanValue: p1}
+			m.PlanModifyMap(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true, diags
+			}
+		}
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		c, d1 := config.(basetypes.ObjectValuable).ToObjectValue(ctx)
+		p1, d2 := plan.(basetypes.ObjectValuable).ToObjectValue(ctx)
+		s, d3 := state.(basetypes.ObjectValuable).ToObjectValue(ctx)
+		diags.Append(d1...)
+		diags.Append(d2...)
+		diags.Append(d3...)
+		for _, m := range sa.ObjectPlanModifiers() {
+			req := planmodifier.ObjectRequest{Path: p, PathExpression: p.Expression(), Config: request.Config, ConfigValue: c, Plan: response.Plan, PlanValue: p1, State: request.State, StateValue: s}
+			resp := planmodifier.ObjectResponse{PlanValue: p1}
+			m.PlanModifyObject(ctx, req, &resp)
+			if resp.RequiresReplace {
+				return true, diags
+			}
+		}
+	}
+
+	return false, diags
+}
+
+// stringFromValue returns the value of the specified string attribute in the specified object value.
+func stringFromValue(v tftypes.Value, name string) string {
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return ""
+	}
+
+	var s *string
+	if v, ok := attrs[name]; ok && v.IsKnown() {
+		if err := v.As(&s); err != nil || s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	return ""
+}
+
+// stringMapFromValue returns the value of the specified map of strings attribute in the specified object value.
+func stringMapFromValue(v tftypes.Value, name string) map[string]string {
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return nil
+	}
+
+	v, ok := attrs[name]
+	if !ok || !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	var elems map[string]tftypes.Value
+	if err := v.As(&elems); err != nil {
+		return nil
+	}
+
+	m := make(map[string]string, len(elems))
+	for k, v := range elems {
+		var s *string
+		if err := v.As(&s); err == nil && s != nil {
+			m[k] = *s
+		}
+	}
+
+	return m
+}
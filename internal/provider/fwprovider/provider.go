@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -148,6 +150,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"timeout_multiplier": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The multiplier applied to the default timeouts of all resources, e.g. `2` to double them.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(1),
+				},
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -167,9 +176,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM Roles to assume prior to making API calls. Multiple IAM Roles are assumed in order, each using the credentials of the previous IAM Role.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -252,6 +259,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"audit_log": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to write a structured audit log of AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"include_parameters": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Include API call parameters, with any secrets and payloads redacted, in audit log records.",
+						},
+						"path": schema.StringAttribute{
+							Optional: true,
+							Description: "Path of the JSON Lines file that audit log records are appended to. " +
+								"Can also be configured using the `" + conns.AuditLogPathEnvVar + "` environment variable.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -288,6 +314,89 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"prevent_destroy": schema.ListNestedBlock{
+				Description: "Rules that protect matching resources from being destroyed or replaced.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Glob patterns matched against resource type names, e.g. `aws_db_*`.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tags that a resource must have, including any default tags.",
+						},
+					},
+				},
+			},
+			"service_limits": schema.ListNestedBlock{
+				Description: "Client-side limits on the AWS API calls made for a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be made at once, in excess of `rate`. Defaults to `rate`, rounded up.",
+						},
+						"max_concurrency": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests in flight at any time.",
+						},
+						"rate": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The sustained number of requests per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service package name, e.g. `route53`.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Policy that the tags of all resources, including any default tags, must satisfy.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"severity": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether a policy violation is reported as an `error` or a `warning`. Defaults to `error`.",
+							Validators: []validator.String{
+								enum.FrameworkValidate[tftags.PolicySeverity](),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrKey: schema.ListNestedBlock{
+							Description: "Rules for a tag key.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Values that the tag may have.",
+									},
+									names.AttrName: schema.StringAttribute{
+										Required:    true,
+										Description: "The tag key.",
+									},
+									"pattern": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that the tag's value must match.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag must be present.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -333,7 +442,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -342,13 +451,19 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				return ctx
 			}
 			interceptors := dataSourceInterceptors{}
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+			// All data sources can override the provider's configured AWS Region.
+			_, ok := schemaResponse.Schema.Attributes[names.AttrRegion]
+			region := !ok
+			if region {
+				interceptors = append(interceptors, regionDataSourceInterceptor{})
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -363,7 +478,10 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				// Each data source instance is configured separately, e.g. for the AWS Region override.
+				inner, _ := v.Factory(ctx) // Any error was reported above.
+
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, region)
 			})
 		}
 	}
@@ -404,7 +522,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -413,13 +531,19 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			// All resources can override the provider's configured AWS Region.
+			_, ok := schemaResponse.Schema.Attributes[names.AttrRegion]
+			region := !ok
+			if region {
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -442,8 +566,13 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			interceptors = append(interceptors, preventDestroyResourceInterceptor{typeName: typeName})
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				// Each resource instance is configured separately, e.g. for the AWS Region override.
+				inner, _ := v.Factory(ctx) // Any error was reported above.
+
+				return newWrappedResource(bootstrapContext, inner, interceptors, region)
 			})
 		}
 	}
@@ -465,8 +594,15 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchesFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewARNResourceFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewSubnetCIDRsFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewUserDataMultipartFunction,
 	}
 }
 
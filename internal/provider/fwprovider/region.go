@@ -0,0 +1,706 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration."
+)
+
+// dataSourceRegionAttribute returns the schema for the per-data source `region` argument.
+func dataSourceRegionAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+// resourceRegionAttribute returns the schema for the per-resource `region` argument.
+// Replacement on change is handled in the wrapped resource's ModifyPlan.
+func resourceRegionAttribute() rschema.StringAttribute {
+	return rschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+// regionDataSourceInterceptor implements the per-data source AWS Region override.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(overrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements the per-resource AWS Region override.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(overrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(overrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(overrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(overrideRegion(ctx, meta, region)...)
+	}
+
+	return ctx, diags
+}
+
+// overrideRegion validates any configured AWS Region and sets it as the Region override in Context.
+func overrideRegion(ctx context.Context, meta *conns.AWSClient, region fwtypes.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil || region.IsNull() || region.IsUnknown() || region.ValueString() == "" {
+		return diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	// Validate the Region and prime the per-Region client.
+	if _, err := meta.ForRegion(ctx, region.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid AWS Region", err.Error())
+
+		return diags
+	}
+
+	inContext.OverrideRegion = region.ValueString()
+
+	return diags
+}
+
+// metaForContext returns the provider Meta (instance data) for any AWS Region override in Context.
+func metaForContext(ctx context.Context, meta *conns.AWSClient) *conns.AWSClient {
+	if meta != nil {
+		// Any Region override has been validated by a Before interceptor.
+		if v, err := meta.ForContext(ctx); err == nil {
+			return v
+		}
+	}
+
+	return meta
+}
+
+// withoutRegion returns the specified object value with any `region` attribute removed.
+// typ is the Terraform type of the inner (un-injected) schema.
+func withoutRegion(ctx context.Context, v tftypes.Value, typ attr.Type) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfType := typ.TerraformType(ctx)
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(tfType, tftypes.UnknownValue), diags
+	}
+
+	if v.IsNull() {
+		return tftypes.NewValue(tfType, nil), diags
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		diags.AddError("Removing region attribute", err.Error())
+
+		return v, diags
+	}
+
+	delete(attrs, names.AttrRegion)
+
+	return tftypes.NewValue(tfType, attrs), diags
+}
+
+// withRegion returns the specified object value with the `region` attribute set.
+// typ is the Terraform type of the outer (injected) schema.
+func withRegion(ctx context.Context, v tftypes.Value, typ attr.Type, region fwtypes.String) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfType := typ.TerraformType(ctx)
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(tfType, tftypes.UnknownValue), diags
+	}
+
+	if v.IsNull() {
+		return tftypes.NewValue(tfType, nil), diags
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		diags.AddError("Adding region attribute", err.Error())
+
+		return v, diags
+	}
+
+	regionValue, err := region.ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddError("Adding region attribute", err.Error())
+
+		return v, diags
+	}
+
+	attrs[names.AttrRegion] = regionValue
+
+	return tftypes.NewValue(tfType, attrs), diags
+}
+
+// regionFromValue returns the value of the `region` attribute in the specified object value.
+func regionFromValue(v tftypes.Value) fwtypes.String {
+	if v.IsNull() || !v.IsKnown() {
+		return fwtypes.StringNull()
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return fwtypes.StringNull()
+	}
+
+	regionValue, ok := attrs[names.AttrRegion]
+	if !ok {
+		return fwtypes.StringNull()
+	}
+
+	if !regionValue.IsKnown() {
+		return fwtypes.StringUnknown()
+	}
+
+	var region *string
+	if err := regionValue.As(&region); err != nil || region == nil {
+		return fwtypes.StringNull()
+	}
+
+	return fwtypes.StringValue(*region)
+}
+
+// defaultRegion returns the specified AWS Region value, or the provider's configured Region if null or empty.
+func defaultRegion(region fwtypes.String, meta *conns.AWSClient) fwtypes.String {
+	if region.IsUnknown() {
+		return region
+	}
+
+	if region.IsNull() || region.ValueString() == "" {
+		if meta == nil {
+			return fwtypes.StringNull()
+		}
+
+		return fwtypes.StringValue(meta.Region)
+	}
+
+	return region
+}
+
+// The wrapped data source's and resource's own schemas do not include an injected `region` attribute.
+// The following methods translate requests and responses between the provider's (outer) schema and the inner schema.
+
+// innerSchema returns the wrapped data source's own schema.
+func (w *wrappedDataSource) innerSchema(ctx context.Context) dsschema.Schema {
+	response := datasource.SchemaResponse{}
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+
+	return response.Schema
+}
+
+// readWithRegion invokes the wrapped data source's Read method, translating between schemas.
+func (w *wrappedDataSource) readWithRegion(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	innerSchema := w.innerSchema(ctx)
+	innerType := innerSchema.Type()
+	region := defaultRegion(regionFromValue(request.Config.Raw), w.meta)
+
+	config, d := withoutRegion(ctx, request.Config.Raw, innerType)
+	diags.Append(d...)
+	state, d := withoutRegion(ctx, response.State.Raw, innerType)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	innerRequest := datasource.ReadRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: innerSchema},
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := datasource.ReadResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Diagnostics: response.Diagnostics,
+	}
+
+	w.configureInner(ctx)
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	state, d = withRegion(ctx, innerResponse.State.Raw, response.State.Schema.Type(), region)
+	response.Diagnostics.Append(d...)
+	response.State.Raw = state
+
+	return response.Diagnostics
+}
+
+// configureInner configures the wrapped data source with the provider Meta for any AWS Region override in Context.
+func (w *wrappedDataSource) configureInner(ctx context.Context) {
+	if meta := metaForContext(ctx, w.meta); meta != w.meta {
+		w.inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: meta}, &datasource.ConfigureResponse{})
+	}
+}
+
+// innerSchema returns the wrapped resource's own schema.
+func (w *wrappedResource) innerSchema(ctx context.Context) rschema.Schema {
+	response := resource.SchemaResponse{}
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	return response.Schema
+}
+
+// configureInner configures the wrapped resource with the provider Meta for any AWS Region override in Context.
+func (w *wrappedResource) configureInner(ctx context.Context) {
+	if meta := metaForContext(ctx, w.meta); meta != w.meta {
+		w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &resource.ConfigureResponse{})
+	}
+}
+
+// createWithRegion invokes the wrapped resource's Create method, translating between schemas.
+func (w *wrappedResource) createWithRegion(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	innerSchema := w.innerSchema(ctx)
+	innerType := innerSchema.Type()
+	region := defaultRegion(regionFromValue(request.Plan.Raw), w.meta)
+
+	config, d := withoutRegion(ctx, request.Config.Raw, innerType)
+	diags.Append(d...)
+	plan, d := withoutRegion(ctx, request.Plan.Raw, innerType)
+	diags.Append(d...)
+	state, d := withoutRegion(ctx, response.State.Raw, innerType)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	innerRequest := resource.CreateRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: innerSchema},
+		Plan:         tfsdk.Plan{Raw: plan, Schema: innerSchema},
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := resource.CreateResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Private:     response.Private,
+		Diagnostics: response.Diagnostics,
+	}
+
+	w.configureInner(ctx)
+	w.inner.Create(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	state, d = withRegion(ctx, innerResponse.State.Raw, response.State.Schema.Type(), region)
+	response.Diagnostics.Append(d...)
+	response.State.Raw = state
+
+	return response.Diagnostics
+}
+
+// readWithRegion invokes the wrapped resource's Read method, translating between schemas.
+func (w *wrappedResource) readWithRegion(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	innerSchema := w.innerSchema(ctx)
+	innerType := innerSchema.Type()
+	// Resources created before the `region` argument was introduced have no value in state.
+	region := defaultRegion(regionFromValue(request.State.Raw), w.meta)
+
+	priorState, d := withoutRegion(ctx, request.State.Raw, innerType)
+	diags.Append(d...)
+	state, d := withoutRegion(ctx, response.State.Raw, innerType)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	innerRequest := resource.ReadRequest{
+		State:        tfsdk.State{Raw: priorState, Schema: innerSchema},
+		Private:      request.Private,
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := resource.ReadResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Private:     response.Private,
+		Diagnostics: response.Diagnostics,
+	}
+
+	w.configureInner(ctx)
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	state, d = withRegion(ctx, innerResponse.State.Raw, response.State.Schema.Type(), region)
+	response.Diagnostics.Append(d...)
+	response.State.Raw = state
+
+	return response.Diagnostics
+}
+
+// updateWithRegion invokes the wrapped resource's Update method, translating between schemas.
+func (w *wrappedResource) updateWithRegion(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	innerSchema := w.innerSchema(ctx)
+	innerType := innerSchema.Type()
+	region := defaultRegion(regionFromValue(request.Plan.Raw), w.meta)
+
+	config, d := withoutRegion(ctx, request.Config.Raw, innerType)
+	diags.Append(d...)
+	plan, d := withoutRegion(ctx, request.Plan.Raw, innerType)
+	diags.Append(d...)
+	priorState, d := withoutRegion(ctx, request.State.Raw, innerType)
+	diags.Append(d...)
+	state, d := withoutRegion(ctx, response.State.Raw, innerType)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	innerRequest := resource.UpdateRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: innerSchema},
+		Plan:         tfsdk.Plan{Raw: plan, Schema: innerSchema},
+		State:        tfsdk.State{Raw: priorState, Schema: innerSchema},
+		Private:      request.Private,
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := resource.UpdateResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Private:     response.Private,
+		Diagnostics: response.Diagnostics,
+	}
+
+	w.configureInner(ctx)
+	w.inner.Update(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	state, d = withRegion(ctx, innerResponse.State.Raw, response.State.Schema.Type(), region)
+	response.Diagnostics.Append(d...)
+	response.State.Raw = state
+
+	return response.Diagnostics
+}
+
+// deleteWithRegion invokes the wrapped resource's Delete method, translating between schemas.
+func (w *wrappedResource) deleteWithRegion(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	innerSchema := w.innerSchema(ctx)
+	innerType := innerSchema.Type()
+	region := regionFromValue(request.State.Raw)
+
+	priorState, d := withoutRegion(ctx, request.State.Raw, innerType)
+	diags.Append(d...)
+	state, d := withoutRegion(ctx, response.State.Raw, innerType)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	innerRequest := resource.DeleteRequest{
+		State:        tfsdk.State{Raw: priorState, Schema: innerSchema},
+		Private:      request.Private,
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := resource.DeleteResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Private:     response.Private,
+		Diagnostics: response.Diagnostics,
+	}
+
+	w.configureInner(ctx)
+	w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	state, d = withRegion(ctx, innerResponse.State.Raw, response.State.Schema.Type(), region)
+	response.Diagnostics.Append(d...)
+	response.State.Raw = state
+
+	return response.Diagnostics
+}
+
+// importStateWithRegion invokes the wrapped resource's ImportState method, translating between schemas.
+// The import ID may be of the form `<id>@<region>`.
+func (w *wrappedResource) importStateWithRegion(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id, r := conns.SplitImportIDRegion(request.ID)
+	region := fwtypes.StringNull()
+	if r != "" {
+		region = fwtypes.StringValue(r)
+
+		response.Diagnostics.Append(overrideRegion(ctx, w.meta, region)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	innerSchema := w.innerSchema(ctx)
+
+	state, d := withoutRegion(ctx, response.State.Raw, innerSchema.Type())
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := resource.ImportStateRequest{
+		ID: id,
+	}
+	innerResponse := resource.ImportStateResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Private:     response.Private,
+		Diagnostics: response.Diagnostics,
+	}
+
+	w.configureInner(ctx)
+	inner.ImportState(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	state, d = withRegion(ctx, innerResponse.State.Raw, response.State.Schema.Type(), region)
+	response.Diagnostics.Append(d...)
+	response.State.Raw = state
+}
+
+// modifyPlanWithRegion sets the planned value of the `region` argument and invokes any wrapped resource's ModifyPlan method, translating between schemas.
+// An unconfigured `region` defaults to the provider's configured AWS Region,
+// so a change to the provider's Region causes resources to be replaced in the new Region.
+func (w *wrappedResource) modifyPlanWithRegion(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	region := fwtypes.StringNull()
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if !request.Plan.Raw.IsNull() {
+		region = defaultRegion(regionFromValue(request.Config.Raw), w.meta)
+
+		if !request.State.Raw.IsNull() {
+			// Don't replace resources that have no Region in state, e.g. when refresh is skipped after a provider upgrade.
+			if stateRegion := regionFromValue(request.State.Raw); !stateRegion.IsNull() && stateRegion.ValueString() != "" && !stateRegion.Equal(region) {
+				response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+			}
+		}
+	}
+
+	inner, ok := w.inner.(resource.ResourceWithModifyPlan)
+	if !ok {
+		if !request.Plan.Raw.IsNull() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+		}
+
+		return
+	}
+
+	innerSchema := w.innerSchema(ctx)
+	innerType := innerSchema.Type()
+
+	var diags diag.Diagnostics
+	config, d := withoutRegion(ctx, request.Config.Raw, innerType)
+	diags.Append(d...)
+	plan, d := withoutRegion(ctx, request.Plan.Raw, innerType)
+	diags.Append(d...)
+	priorState, d := withoutRegion(ctx, request.State.Raw, innerType)
+	diags.Append(d...)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := resource.ModifyPlanRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: innerSchema},
+		Plan:         tfsdk.Plan{Raw: plan, Schema: innerSchema},
+		State:        tfsdk.State{Raw: priorState, Schema: innerSchema},
+		Private:      request.Private,
+		ProviderMeta: request.ProviderMeta,
+	}
+	innerResponse := resource.ModifyPlanResponse{
+		Plan:            tfsdk.Plan{Raw: plan, Schema: innerSchema},
+		RequiresReplace: response.RequiresReplace,
+		Private:         response.Private,
+		Diagnostics:     response.Diagnostics,
+	}
+
+	if !region.IsNull() {
+		ctx = contextWithRegion(ctx, region)
+	}
+	w.configureInner(ctx)
+	inner.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	response.RequiresReplace = innerResponse.RequiresReplace
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	plan, d = withRegion(ctx, innerResponse.Plan.Raw, response.Plan.Schema.Type(), region)
+	response.Diagnostics.Append(d...)
+	response.Plan.Raw = plan
+}
+
+// validateConfigWithRegion invokes the wrapped resource's ValidateConfig method, translating between schemas.
+func (w *wrappedResource) validateConfigWithRegion(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	innerSchema := w.innerSchema(ctx)
+
+	config, d := withoutRegion(ctx, request.Config.Raw, innerSchema.Type())
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Raw: config, Schema: innerSchema},
+	}
+
+	inner.ValidateConfig(ctx, innerRequest, response)
+}
+
+// upgradeStateWithRegion wraps the wrapped resource's state upgraders, translating between schemas.
+// Prior state never includes the `region` attribute; the upgraded state has a null value.
+func (w *wrappedResource) upgradeStateWithRegion(ctx context.Context, upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	for version, upgrader := range upgraders {
+		f := upgrader.StateUpgrader
+		upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			innerSchema := w.innerSchema(ctx)
+
+			state, d := withoutRegion(ctx, response.State.Raw, innerSchema.Type())
+			response.Diagnostics.Append(d...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			innerResponse := resource.UpgradeStateResponse{
+				DynamicValue: response.DynamicValue,
+				State:        tfsdk.State{Raw: state, Schema: innerSchema},
+				Diagnostics:  response.Diagnostics,
+			}
+
+			f(ctx, request, &innerResponse)
+
+			response.DynamicValue = innerResponse.DynamicValue
+			response.Diagnostics = innerResponse.Diagnostics
+			state, d = withRegion(ctx, innerResponse.State.Raw, response.State.Schema.Type(), fwtypes.StringNull())
+			response.Diagnostics.Append(d...)
+			response.State.Raw = state
+		}
+		upgraders[version] = upgrader
+	}
+
+	return upgraders
+}
+
+// moveStateWithRegion wraps the wrapped resource's state movers, translating between schemas.
+func (w *wrappedResource) moveStateWithRegion(ctx context.Context, movers []resource.StateMover) []resource.StateMover {
+	for i, mover := range movers {
+		f := mover.StateMover
+		mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			innerSchema := w.innerSchema(ctx)
+
+			state, d := withoutRegion(ctx, response.TargetState.Raw, innerSchema.Type())
+			response.Diagnostics.Append(d...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			innerResponse := resource.MoveStateResponse{
+				TargetPrivate: response.TargetPrivate,
+				TargetState:   tfsdk.State{Raw: state, Schema: innerSchema},
+				Diagnostics:   response.Diagnostics,
+			}
+
+			f(ctx, request, &innerResponse)
+
+			response.TargetPrivate = innerResponse.TargetPrivate
+			response.Diagnostics = innerResponse.Diagnostics
+			state, d = withRegion(ctx, innerResponse.TargetState.Raw, response.TargetState.Schema.Type(), fwtypes.StringNull())
+			response.Diagnostics.Append(d...)
+			response.TargetState.Raw = state
+		}
+		movers[i] = mover
+	}
+
+	return movers
+}
+
+// contextWithRegion sets any AWS Region override in Context.
+func contextWithRegion(ctx context.Context, region fwtypes.String) context.Context {
+	if inContext, ok := conns.FromContext(ctx); ok && !region.IsUnknown() {
+		inContext.OverrideRegion = region.ValueString()
+	}
+
+	return ctx
+}
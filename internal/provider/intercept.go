@@ -79,6 +79,22 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+// phase returns the CRUD phase kept in Context for a single CRUD operation.
+func (w why) phase() string {
+	switch w {
+	case Create:
+		return conns.PhaseCreate
+	case Read:
+		return conns.PhaseRead
+	case Update:
+		return conns.PhaseUpdate
+	case Delete:
+		return conns.PhaseDelete
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -93,6 +109,13 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+
+		var id string
+		if d != nil {
+			id = d.Id()
+		}
+		conns.SetPhase(ctx, why.phase(), id)
+
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -110,7 +133,7 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
+		diags = f(ctx, d, metaForContext(ctx, meta))
 
 		if diags.HasError() {
 			when = OnError
@@ -174,6 +197,7 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
+		conns.SetPhase(ctx, conns.PhaseImport, d.Id())
 
 		return f(ctx, d, meta)
 	}
@@ -182,6 +206,7 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
+		conns.SetPhase(ctx, conns.PhasePlan, d.Id())
 
 		return f(ctx, d, meta)
 	}
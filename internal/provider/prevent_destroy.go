@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// preventDestroyInterceptor refuses to delete resources matching the provider's `prevent_destroy` rules.
+type preventDestroyInterceptor struct {
+	typeName string
+}
+
+func (r preventDestroyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.PreventDestroyConfig == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Delete:
+			var tags map[string]string
+			if v, ok := d.Get(names.AttrTagsAll).(map[string]interface{}); ok {
+				tags = flex.ExpandStringValueMap(v)
+			}
+
+			if rule, ok := c.PreventDestroyConfig.Match(r.typeName, tags); ok {
+				return ctx, sdkdiag.AppendFromErr(diags, conns.PreventDestroyError(r.typeName, d.Id(), rule))
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// preventDestroyProviderServer wraps the Plugin SDK provider server and fails any plan that would destroy or replace
+// a resource matching the provider's `prevent_destroy` rules, as Plugin Framework resources do.
+// Replacement is only known once the Plugin SDK has planned the change, including any attributes
+// that CustomizeDiff forces to require replacement.
+type preventDestroyProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func (s *preventDestroyProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	c, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok || c.PreventDestroyConfig == nil {
+		return response, nil
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	state, err := unmarshalDynamicValue(request.PriorState, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return response, err
+	}
+
+	// Resource creation.
+	if state.IsNull() {
+		return response, nil
+	}
+
+	// Resource update that doesn't require replacement.
+	if len(response.RequiresReplace) == 0 {
+		plan, err := unmarshalDynamicValue(request.ProposedNewState, r.CoreConfigSchema().ImpliedType())
+		if err != nil {
+			return response, err
+		}
+
+		// Resource destruction.
+		if !plan.IsNull() {
+			return response, nil
+		}
+	}
+
+	if rule, ok := c.PreventDestroyConfig.Match(request.TypeName, stringMapFromValue(state, names.AttrTagsAll)); ok {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Resource destruction prevented",
+			Detail:   conns.PreventDestroyError(request.TypeName, stringFromValue(state, names.AttrID), rule).Error(),
+		})
+	}
+
+	return response, nil
+}
+
+// unmarshalDynamicValue decodes the specified value, which is null if not set.
+func unmarshalDynamicValue(v *tfprotov5.DynamicValue, ty cty.Type) (cty.Value, error) {
+	switch {
+	case v == nil:
+		return cty.NullVal(ty), nil
+	case len(v.MsgPack) > 0:
+		return ctymsgpack.Unmarshal(v.MsgPack, ty)
+	case len(v.JSON) > 0:
+		return ctyjson.Unmarshal(v.JSON, ty)
+	default:
+		return cty.NullVal(ty), nil
+	}
+}
+
+// stringFromValue returns the value of the specified string attribute in the specified object value.
+func stringFromValue(v cty.Value, name string) string {
+	if !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+		return ""
+	}
+
+	v = v.GetAttr(name)
+	if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return v.AsString()
+}
+
+// stringMapFromValue returns the value of the specified map of strings attribute in the specified object value.
+func stringMapFromValue(v cty.Value, name string) map[string]string {
+	if !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+		return nil
+	}
+
+	v = v.GetAttr(name)
+	if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.Map(cty.String)) {
+		return nil
+	}
+
+	m := make(map[string]string, v.LengthInt())
+	for k, v := range v.AsValueMap() {
+		if v.IsKnown() && !v.IsNull() {
+			m[k] = v.AsString()
+		}
+	}
+
+	return m
+}
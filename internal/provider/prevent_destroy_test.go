@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPreventDestroyProviderServerPlanResourceChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	noop := func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil }
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrTagsAll: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		// Replacement is only forced by CustomizeDiff.
+		CustomizeDiff:        customdiff.ForceNewIfChange(names.AttrName, func(context.Context, any, any, any) bool { return true }),
+		CreateWithoutTimeout: noop,
+		ReadWithoutTimeout:   noop,
+		UpdateWithoutTimeout: noop,
+		DeleteWithoutTimeout: noop,
+	}
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": r,
+		},
+	}
+	p.SetMeta(&conns.AWSClient{
+		PreventDestroyConfig: &conns.PreventDestroyConfig{
+			Rules: []conns.PreventDestroyRule{{Tags: map[string]string{"Protected": "true"}}},
+		},
+	})
+	server := &preventDestroyProviderServer{
+		ProviderServer: p.GRPCProvider(),
+		provider:       p,
+	}
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	value := func(t *testing.T, id, description, name string, tags map[string]string) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		idVal := cty.StringVal(id)
+		if id == "" {
+			idVal = cty.NullVal(cty.String)
+		}
+		tagsVal := cty.NullVal(cty.Map(cty.String))
+		if len(tags) > 0 {
+			m := make(map[string]cty.Value, len(tags))
+			for k, v := range tags {
+				m[k] = cty.StringVal(v)
+			}
+			tagsVal = cty.MapVal(m)
+		}
+
+		b, err := ctymsgpack.Marshal(cty.ObjectVal(map[string]cty.Value{
+			names.AttrID:          idVal,
+			names.AttrDescription: cty.StringVal(description),
+			names.AttrName:        cty.StringVal(name),
+			names.AttrTagsAll:     tagsVal,
+		}), ty)
+		if err != nil {
+			t.Fatalf("marshaling value: %s", err)
+		}
+
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+
+	null := func(t *testing.T) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		b, err := ctymsgpack.Marshal(cty.NullVal(ty), ty)
+		if err != nil {
+			t.Fatalf("marshaling value: %s", err)
+		}
+
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+
+	protected := map[string]string{"Protected": "true"}
+	testCases := map[string]struct {
+		priorDescription, priorName string
+		tags                        map[string]string
+		newDescription, newName     string
+		destroy                     bool
+		wantError                   bool
+	}{
+		"destroy": {
+			priorDescription: "a",
+			priorName:        "test",
+			tags:             protected,
+			destroy:          true,
+			wantError:        true,
+		},
+		"destroy not protected": {
+			priorDescription: "a",
+			priorName:        "test",
+			destroy:          true,
+		},
+		"in-place update": {
+			priorDescription: "a",
+			priorName:        "test",
+			tags:             protected,
+			newDescription:   "b",
+			newName:          "test",
+		},
+		"replacement": {
+			priorDescription: "a",
+			priorName:        "test",
+			tags:             protected,
+			newDescription:   "a",
+			newName:          "test2",
+			wantError:        true,
+		},
+		"replacement not protected": {
+			priorDescription: "a",
+			priorName:        "test",
+			newDescription:   "a",
+			newName:          "test2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := null(t)
+			proposedNewState := null(t)
+			if !testCase.destroy {
+				config = value(t, "", testCase.newDescription, testCase.newName, testCase.tags)
+				proposedNewState = value(t, "test-id", testCase.newDescription, testCase.newName, testCase.tags)
+			}
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "aws_test",
+				Config:           config,
+				PriorState:       value(t, "test-id", testCase.priorDescription, testCase.priorName, testCase.tags),
+				ProposedNewState: proposedNewState,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotError bool
+			for _, d := range response.Diagnostics {
+				if d.Severity == tfprotov5.DiagnosticSeverityError {
+					if d.Summary != "Resource destruction prevented" {
+						t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+					}
+					gotError = true
+				}
+			}
+
+			if got, want := gotError, testCase.wantError; got != want {
+				t.Errorf("destruction prevented = %t, want %t", got, want)
+			}
+		})
+	}
+}
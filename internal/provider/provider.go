@@ -18,9 +18,11 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -51,6 +53,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to write a structured audit log of AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_parameters": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Include API call parameters, with any secrets and payloads redacted, in audit log records.",
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Path of the JSON Lines file that audit log records are appended to. " +
+								"Can also be configured using the `" + conns.AuditLogPathEnvVar + "` environment variable.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -146,6 +169,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"prevent_destroy": preventDestroySchema(),
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +209,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_limits": serviceLimitsSchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -228,6 +253,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": tagPolicySchema(),
+			"timeout_multiplier": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Description:  "The multiplier applied to the default timeouts of all resources, e.g. `2` to double them.",
+				ValidateFunc: validation.FloatAtLeast(1),
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -258,8 +290,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
+	var timeouts defaultTimeouts
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+		meta, diags := configure(ctx, provider, d)
+
+		if meta != nil {
+			timeouts.scale(provider.ResourcesMap, meta.TimeoutMultiplier)
+		}
+
+		return meta, diags
 	}
 
 	var errs []error
@@ -288,7 +327,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -298,6 +337,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// All data sources can override the provider's configured AWS Region.
+			if injectRegionSchema(r, false) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -365,7 +413,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -375,6 +423,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// All resources can override the provider's configured AWS Region.
+			region := injectRegionSchema(r, true)
+			if region {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -410,6 +468,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			interceptors = append(interceptors, interceptorItem{
+				when: Before,
+				why:  Delete,
+				interceptor: preventDestroyInterceptor{
+					typeName: typeName,
+				},
+			})
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -429,9 +495,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
+					if region {
+						v = importStateWithRegion(v)
+					}
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
+			if region {
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(setRegionDiff, customizeDiffWithRegion(v))
+				} else {
+					r.CustomizeDiff = setRegionDiff
+				}
+			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
@@ -449,6 +525,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		return nil, err
 	}
 
+	timeouts = newDefaultTimeouts(provider.ResourcesMap)
+
 	// Set the provider Meta (instance data) here.
 	// It will be overwritten by the result of the call to ConfigureContextFunc,
 	// but can be used pre-configuration by other (non-primary) provider servers.
@@ -514,14 +592,23 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+			config.AssumeRole = append(config.AssumeRole, *assumeRole)
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -532,6 +619,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("audit_log"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.AuditLogConfig = expandAuditLog(ctx, v.([]interface{})[0].(map[string]interface{}))
+	} else if v := os.Getenv(conns.AuditLogPathEnvVar); v != "" {
+		config.AuditLogConfig = &conns.AuditLogConfig{
+			Path: v,
+		}
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -559,6 +654,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if v, ok := d.GetOk("prevent_destroy"); ok && len(v.([]interface{})) > 0 {
+		config.PreventDestroyConfig = expandPreventDestroy(ctx, v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.TagPolicyConfig = expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.Get("no_proxy").(string); ok && v != "" {
 		config.NoProxy = v
 	}
@@ -571,6 +674,19 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("timeout_multiplier"); ok {
+		config.TimeoutMultiplier = v.(float64)
+	}
+
+	if v, ok := d.GetOk("service_limits"); ok && len(v.([]interface{})) > 0 {
+		limits, dx := expandServiceLimits(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceLimits = limits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -605,9 +721,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume prior to making API calls. Multiple IAM Roles are assumed in order, each using the credentials of the previous IAM Role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -731,6 +847,116 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func preventDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Rules that protect matching resources from being destroyed or replaced.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Glob patterns matched against resource type names, e.g. `aws_db_*`.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: "Tags that a resource must have, including any default tags.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func serviceLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Client-side limits on the AWS API calls made for a service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of requests that can be made at once, in excess of `rate`. Defaults to `rate`, rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_concurrency": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of requests in flight at any time.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"rate": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The sustained number of requests per second.",
+					ValidateFunc: validation.FloatAtLeast(0.001),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service package name, e.g. `route53`.",
+					ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+				},
+			},
+		},
+	}
+}
+
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Policy that the tags of all resources, including any default tags, must satisfy.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrKey: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Rules for a tag key.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_values": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "Values that the tag may have.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrName: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The tag key.",
+							},
+							"pattern": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Regular expression that the tag's value must match.",
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							"required": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether the tag must be present.",
+							},
+						},
+					},
+				},
+				"severity": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Whether a policy violation is reported as an `error` or a `warning`. Defaults to `error`.",
+					ValidateFunc: validation.StringInSlice(enum.Values[tftags.PolicySeverity](), false),
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -838,6 +1064,26 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+func expandAuditLog(_ context.Context, tfMap map[string]interface{}) *conns.AuditLogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	auditLogConfig := &conns.AuditLogConfig{
+		Path: os.Getenv(conns.AuditLogPathEnvVar),
+	}
+
+	if v, ok := tfMap["include_parameters"].(bool); ok {
+		auditLogConfig.IncludeParameters = v
+	}
+
+	if v, ok := tfMap["path"].(string); ok && v != "" {
+		auditLogConfig.Path = v
+	}
+
+	return auditLogConfig
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1116,118 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandPreventDestroy(_ context.Context, tfList []interface{}) *conns.PreventDestroyConfig {
+	preventDestroyConfig := &conns.PreventDestroyConfig{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := conns.PreventDestroyRule{}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			rule.Tags = flex.ExpandStringValueMap(v)
+		}
+
+		preventDestroyConfig.Rules = append(preventDestroyConfig.Rules, rule)
+	}
+
+	return preventDestroyConfig
+}
+
+func expandServiceLimits(_ context.Context, tfList []interface{}) (map[string]conns.ServiceLimits, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	limits := make(map[string]conns.ServiceLimits)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		if _, ok := limits[service]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				cty.GetAttrPath("service_limits").IndexInt(i).GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate service_limits for service %q", service),
+			))
+			continue
+		}
+
+		var v conns.ServiceLimits
+
+		if n, ok := tfMap["burst"].(int); ok {
+			v.Burst = n
+		}
+
+		if n, ok := tfMap["max_concurrency"].(int); ok {
+			v.MaxConcurrency = n
+		}
+
+		if n, ok := tfMap["rate"].(float64); ok {
+			v.Rate = n
+		}
+
+		limits[service] = v
+	}
+
+	return limits, diags
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) *tftags.PolicyConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{
+		Severity: tftags.PolicySeverityError,
+	}
+
+	if v, ok := tfMap["severity"].(string); ok && v != "" {
+		policyConfig.Severity = tftags.PolicySeverity(v)
+	}
+
+	if v, ok := tfMap[names.AttrKey].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key := tftags.PolicyKey{}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				key.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap[names.AttrName].(string); ok {
+				key.Name = v
+			}
+
+			if v, ok := tfMap["pattern"].(string); ok && v != "" {
+				// The pattern has been validated by the schema.
+				key.Pattern = regexache.MustCompile(v)
+			}
+
+			if v, ok := tfMap["required"].(bool); ok {
+				key.Required = v
+			}
+
+			policyConfig.Keys = append(policyConfig.Keys, key)
+		}
+	}
+
+	return policyConfig
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"maps"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -380,3 +381,137 @@ func TestProviderConfig_Authentication_SSO(t *testing.T) { //nolint:paralleltest
 func TestProviderConfig_Authentication_LegacySSO(t *testing.T) { //nolint:paralleltest
 	configtesting.LegacySSO(t, &testDriver{})
 }
+
+func TestProviderConfig_AssumeRoleChain(t *testing.T) { //nolint:paralleltest
+	const secondRoleARN = "arn:aws:iam::666666666666:role/SecondRole"
+
+	testcases := map[string]struct {
+		AssumeRole    []any
+		MockEndpoints []*servicemocks.MockEndpoint
+		ExpectedError string
+	}{
+		"multiple hops": {
+			AssumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     secondRoleARN,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			MockEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{"RoleArn": secondRoleARN}),
+			},
+		},
+		"first hop fails": {
+			AssumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     secondRoleARN,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			MockEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleInvalidEndpointInvalidClientTokenId,
+			},
+			ExpectedError: "assume_role 1 of 2: assuming IAM Role (" + servicemocks.MockStsAssumeRoleArn + ")",
+		},
+		"second hop fails": {
+			AssumeRole: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     secondRoleARN,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			MockEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+			ExpectedError: "assume_role 2 of 2: assuming IAM Role (" + secondRoleARN + ")",
+		},
+		"first hop without role_arn": {
+			AssumeRole: []any{
+				map[string]any{
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     secondRoleARN,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			ExpectedError: "assume_role 1 of 2: role_arn is required",
+		},
+	}
+
+	for name, tc := range testcases { //nolint:paralleltest
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			servicemocks.InitSessionTestEnv(t)
+
+			ts := servicemocks.MockAwsApiServer("STS", tc.MockEndpoints)
+			defer ts.Close()
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"assume_role":                 tc.AssumeRole,
+				"endpoints":                   []any{map[string]any{"sts": ts.URL}},
+				"region":                      "us-east-1", //lintignore:AWSAT003
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+
+			rc := terraformsdk.NewResourceConfigRaw(config)
+
+			p, err := New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var diags diag.Diagnostics
+			diags = append(diags, p.Validate(rc)...)
+			if diags.HasError() {
+				t.Fatalf("validating: %s", sdkdiag.DiagnosticsString(diags))
+			}
+
+			diags = append(diags, p.Configure(ctx, rc)...)
+
+			if tc.ExpectedError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %s", sdkdiag.DiagnosticsString(diags))
+				}
+
+				credentials, err := p.Meta().(*conns.AWSClient).AwsConfig(ctx).Credentials.Retrieve(ctx)
+				if err != nil {
+					t.Fatalf("retrieving credentials: %s", err)
+				}
+
+				if a, e := credentials.AccessKeyID, servicemocks.MockStsAssumeRoleAccessKey; a != e {
+					t.Errorf("expected access key %q, got %q", e, a)
+				}
+
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected error containing %q, got none", tc.ExpectedError)
+			}
+
+			if a := sdkdiag.DiagnosticsString(diags); !strings.Contains(a, tc.ExpectedError) {
+				t.Errorf("expected error containing %q, got %q", tc.ExpectedError, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionSchema returns the schema for the per-resource `region` argument.
+func regionSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    forceNew,
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// injectRegionSchema adds the `region` argument to the resource's schema.
+// It returns false if the schema already defines a `region` attribute.
+func injectRegionSchema(r *schema.Resource, forceNew bool) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s[names.AttrRegion] = regionSchema(forceNew)
+			return s
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = regionSchema(forceNew)
+	}
+
+	return true
+}
+
+// regionInterceptor implements the per-resource AWS Region override for resources and data sources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		region, _ := d.Get(names.AttrRegion).(string)
+		if region == "" {
+			return ctx, diags
+		}
+
+		// Validate the Region and prime the per-Region client.
+		if _, err := c.ForRegion(ctx, region); err != nil {
+			return ctx, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		inContext.OverrideRegion = region
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			// Resources created before the `region` argument was introduced have no value in state.
+			if v, _ := d.Get(names.AttrRegion).(string); v == "" {
+				if err := d.Set(names.AttrRegion, c.Region); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// setRegionDiff sets the planned value of the `region` argument when it is not configured.
+// An unconfigured `region` defaults to the provider's configured AWS Region,
+// so a change to the provider's Region causes resources to be replaced in the new Region.
+func setRegionDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	if config := d.GetRawConfig(); config.IsNull() || !config.IsKnown() || !config.GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	o, _ := d.GetChange(names.AttrRegion)
+	old := o.(string)
+
+	if old == c.Region {
+		return nil
+	}
+
+	if err := d.SetNew(names.AttrRegion, c.Region); err != nil {
+		return err
+	}
+
+	// Don't replace resources that have no Region in state, e.g. when refresh is skipped after a provider upgrade.
+	if d.Id() != "" && old != "" {
+		return d.ForceNew(names.AttrRegion)
+	}
+
+	return nil
+}
+
+// customizeDiffWithRegion returns a CustomizeDiff handler that is passed the provider Meta (instance data)
+// for any planned per-resource AWS Region override, as CRUD handlers are.
+func customizeDiffWithRegion(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		// The Region is unknown if it's configured from an unknown value.
+		if region, _ := d.Get(names.AttrRegion).(string); region != "" {
+			if c, ok := meta.(*conns.AWSClient); ok {
+				// Validate the Region and prime the per-Region client.
+				if _, err := c.ForRegion(ctx, region); err != nil {
+					return err
+				}
+			}
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = region
+			}
+		}
+
+		return f(ctx, d, metaForContext(ctx, meta))
+	}
+}
+
+// metaForContext returns the provider Meta (instance data) for any AWS Region override in Context.
+func metaForContext(ctx context.Context, meta any) any {
+	if v, ok := meta.(*conns.AWSClient); ok {
+		// Any Region override has been validated by a Before interceptor.
+		if v, err := v.ForContext(ctx); err == nil {
+			return v
+		}
+	}
+
+	return meta
+}
+
+// importStateWithRegion returns an import handler that accepts an import ID of the form `<id>@<region>`.
+func importStateWithRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		id, region := conns.SplitImportIDRegion(d.Id())
+		if region == "" {
+			return f(ctx, d, meta)
+		}
+
+		if c, ok := meta.(*conns.AWSClient); ok {
+			if _, err := c.ForRegion(ctx, region); err != nil {
+				return nil, err
+			}
+		}
+
+		d.SetId(id)
+		if err := d.Set(names.AttrRegion, region); err != nil {
+			return nil, err
+		}
+
+		if inContext, ok := conns.FromContext(ctx); ok {
+			inContext.OverrideRegion = region
+		}
+
+		return f(ctx, d, metaForContext(ctx, meta))
+	}
+}
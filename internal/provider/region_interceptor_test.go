@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCustomizeDiffWithRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	meta := &conns.AWSClient{
+		Partition: names.StandardPartitionID,
+		Region:    names.USWest2RegionID,
+	}
+
+	testCases := map[string]struct {
+		config     map[string]any
+		wantRegion string
+	}{
+		"not configured": {
+			config:     map[string]any{},
+			wantRegion: names.USWest2RegionID,
+		},
+		"configured": {
+			config: map[string]any{
+				names.AttrRegion: names.USEast1RegionID,
+			},
+			wantRegion: names.USEast1RegionID,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotRegion string
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+				CustomizeDiff: customizeDiffWithRegion(func(_ context.Context, _ *schema.ResourceDiff, meta any) error {
+					gotRegion = meta.(*conns.AWSClient).Region
+					return nil
+				}),
+			}
+			injectRegionSchema(r, true)
+
+			ctx := conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+			if _, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(testCase.config), meta); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := gotRegion, testCase.wantRegion; got != want {
+				t.Errorf("CustomizeDiff Region = %q, want %q", got, want)
+			}
+		})
+	}
+}
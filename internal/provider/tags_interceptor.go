@@ -7,8 +7,11 @@ import (
 	"context"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -172,3 +175,53 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagPolicyProviderServer wraps the Plugin SDK provider server and reports violations of a warning-severity provider tag policy during plan.
+// CustomizeDiff can't return warnings, so violations of an error-severity tag policy are reported by verify.SetTagsDiff instead.
+type tagPolicyProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func (s *tagPolicyProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	c, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok || !c.TagPolicyConfig.IsWarning() {
+		return response, nil
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	if _, ok := r.SchemaMap()[names.AttrTagsAll]; !ok {
+		return response, nil
+	}
+
+	plan, err := unmarshalDynamicValue(response.PlannedState, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return response, err
+	}
+
+	// Resource destruction, or tags not known until apply.
+	if plan.IsNull() || !plan.GetAttr(names.AttrTagsAll).IsWhollyKnown() {
+		return response, nil
+	}
+
+	tags := tftags.New(ctx, stringMapFromValue(plan, names.AttrTagsAll))
+	for _, v := range c.TagPolicyConfig.ViolationsWithSeverity(tags, tftags.PolicySeverityWarning) {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityWarning,
+			Summary:   "Tag policy violation",
+			Detail:    v,
+			Attribute: tftypes.NewAttributePath().WithAttributeName(names.AttrTagsAll),
+		})
+	}
+
+	return response, nil
+}
@@ -9,11 +9,15 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type mockService struct{}
@@ -87,7 +91,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 		}
@@ -148,3 +152,126 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+func TestTagPolicyProviderServerPlanResourceChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	noop := func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil }
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrTagsAll: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CreateWithoutTimeout: noop,
+		ReadWithoutTimeout:   noop,
+		UpdateWithoutTimeout: noop,
+		DeleteWithoutTimeout: noop,
+	}
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	value := func(t *testing.T, tags map[string]string) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		tagsVal := cty.NullVal(cty.Map(cty.String))
+		if len(tags) > 0 {
+			m := make(map[string]cty.Value, len(tags))
+			for k, v := range tags {
+				m[k] = cty.StringVal(v)
+			}
+			tagsVal = cty.MapVal(m)
+		}
+
+		b, err := ctymsgpack.Marshal(cty.ObjectVal(map[string]cty.Value{
+			names.AttrID:      cty.NullVal(cty.String),
+			names.AttrTagsAll: tagsVal,
+		}), ty)
+		if err != nil {
+			t.Fatalf("marshaling value: %s", err)
+		}
+
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+
+	testCases := map[string]struct {
+		severity     tftags.PolicySeverity
+		tags         map[string]string
+		wantWarnings int
+	}{
+		"compliant": {
+			severity: tftags.PolicySeverityWarning,
+			tags:     map[string]string{"Owner": "test"},
+		},
+		"violation": {
+			severity:     tftags.PolicySeverityWarning,
+			tags:         map[string]string{"Name": "test"},
+			wantWarnings: 1,
+		},
+		"error severity": {
+			severity: tftags.PolicySeverityError,
+			tags:     map[string]string{"Name": "test"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &schema.Provider{
+				ResourcesMap: map[string]*schema.Resource{
+					"aws_test": r,
+				},
+			}
+			p.SetMeta(&conns.AWSClient{
+				TagPolicyConfig: &tftags.PolicyConfig{
+					Keys:     []tftags.PolicyKey{{Name: "Owner", Required: true}},
+					Severity: testCase.severity,
+				},
+			})
+			server := &tagPolicyProviderServer{
+				ProviderServer: p.GRPCProvider(),
+				provider:       p,
+			}
+
+			config := value(t, testCase.tags)
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "aws_test",
+				Config:           config,
+				PriorState:       &tfprotov5.DynamicValue{MsgPack: mustMarshalNull(t, ty)},
+				ProposedNewState: config,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotWarnings int
+			for _, d := range response.Diagnostics {
+				switch d.Severity {
+				case tfprotov5.DiagnosticSeverityError:
+					t.Fatalf("unexpected error diagnostic: %s: %s", d.Summary, d.Detail)
+				case tfprotov5.DiagnosticSeverityWarning:
+					gotWarnings++
+				}
+			}
+
+			if got, want := gotWarnings, testCase.wantWarnings; got != want {
+				t.Errorf("warnings = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func mustMarshalNull(t *testing.T, ty cty.Type) []byte {
+	t.Helper()
+
+	b, err := ctymsgpack.Marshal(cty.NullVal(ty), ty)
+	if err != nil {
+		t.Fatalf("marshaling value: %s", err)
+	}
+
+	return b
+}
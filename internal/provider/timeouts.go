@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// defaultTimeouts records the unscaled default timeouts of Plugin SDK resources, keyed by resource type name.
+type defaultTimeouts map[string]schema.ResourceTimeout
+
+func newDefaultTimeouts(resources map[string]*schema.Resource) defaultTimeouts {
+	dt := make(defaultTimeouts)
+
+	for typeName, r := range resources {
+		if v := r.Timeouts; v != nil {
+			dt[typeName] = *v
+		}
+	}
+
+	return dt
+}
+
+// scale sets each resource's default timeouts to the recorded values scaled by the specified multiplier.
+// Plugin SDK resources copy their default timeouts when a resource is planned, so the values must be scaled
+// before any resource is planned.
+func (dt defaultTimeouts) scale(resources map[string]*schema.Resource, multiplier float64) {
+	for typeName, timeouts := range dt {
+		r, ok := resources[typeName]
+		if !ok {
+			continue
+		}
+
+		r.Timeouts = &schema.ResourceTimeout{
+			Create:  scaleTimeout(timeouts.Create, multiplier),
+			Read:    scaleTimeout(timeouts.Read, multiplier),
+			Update:  scaleTimeout(timeouts.Update, multiplier),
+			Delete:  scaleTimeout(timeouts.Delete, multiplier),
+			Default: scaleTimeout(timeouts.Default, multiplier),
+		}
+	}
+}
+
+func scaleTimeout(timeout *time.Duration, multiplier float64) *time.Duration {
+	if timeout == nil {
+		return nil
+	}
+
+	v := conns.ScaleTimeout(*timeout, multiplier)
+
+	return &v
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDefaultTimeoutsScale(t *testing.T) {
+	t.Parallel()
+
+	resources := map[string]*schema.Resource{
+		"aws_no_timeouts": {},
+		"aws_timeouts": {
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(10 * time.Minute),
+				Delete: schema.DefaultTimeout(5 * time.Minute),
+			},
+		},
+	}
+
+	timeouts := newDefaultTimeouts(resources)
+
+	// Scaling is relative to the recorded default timeouts, so repeated scaling doesn't compound.
+	for _, multiplier := range []float64{3, 2} {
+		timeouts.scale(resources, multiplier)
+	}
+
+	if resources["aws_no_timeouts"].Timeouts != nil {
+		t.Errorf("unexpected timeouts: %v", resources["aws_no_timeouts"].Timeouts)
+	}
+
+	want := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(20 * time.Minute),
+		Delete: schema.DefaultTimeout(10 * time.Minute),
+	}
+	if diff := cmp.Diff(resources["aws_timeouts"].Timeouts, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
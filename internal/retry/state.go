@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// StateRefreshFunc is a function type used for StateChangeConf that is
+// responsible for refreshing the item being watched for a state change.
+//
+// It returns three results. `result` is any object that will be returned
+// as the final object after waiting for state change. This allows you to
+// return the final updated object, for example an EC2 instance after refreshing
+// it. A nil result represents not found.
+//
+// `state` is the latest state of that object. And `err` is any error that
+// may have happened while refreshing the state.
+type StateRefreshFunc func() (result any, state string, err error)
+
+// StateRefreshFuncOf is the generic equivalent of StateRefreshFunc.
+type StateRefreshFuncOf[T any, S ~string] func(context.Context) (T, S, error)
+
+// Progress describes the progress of a state change.
+type Progress[S ~string] struct {
+	State     S             // The latest refreshed state.
+	Refreshes int           // Number of calls to Refresh so far.
+	Elapsed   time.Duration // Time since the wait started, including any Delay.
+	Remaining time.Duration // Time until the wait times out.
+}
+
+// ProgressFunc is called after each refresh that doesn't complete the state change.
+type ProgressFunc[S ~string] func(context.Context, Progress[S])
+
+// progressLogInterval is the interval between progress logs at INFO level while the state doesn't change.
+const progressLogInterval = time.Minute
+
+// progressLogger emits structured progress logs for a state change.
+// Every refresh is logged at DEBUG level. The first refresh, state changes, and progress at least every
+// progressLogInterval are logged at INFO level.
+type progressLogger struct {
+	target    string
+	lastState string
+	lastInfo  time.Time
+}
+
+func newProgressLogger[S ~string](target []S) *progressLogger {
+	return &progressLogger{
+		target: strings.Join(toStrings(target), ","),
+	}
+}
+
+func (l *progressLogger) log(ctx context.Context, state string, refreshes int, elapsed, remaining time.Duration) {
+	fields := map[string]any{
+		"tf_aws.wait.state":     state,
+		"tf_aws.wait.target":    l.target,
+		"tf_aws.wait.refreshes": refreshes,
+		"tf_aws.wait.elapsed":   elapsed.Round(time.Second).String(),
+		"tf_aws.wait.remaining": remaining.Round(time.Second).String(),
+	}
+
+	if now := time.Now(); state != l.lastState || now.Sub(l.lastInfo) >= progressLogInterval {
+		l.lastState = state
+		l.lastInfo = now
+		tflog.Info(ctx, "Waiting for state change", fields)
+	} else {
+		tflog.Debug(ctx, "Waiting for state change", fields)
+	}
+}
+
+// StateChangeConf is the configuration struct used for `WaitForStateContext`.
+// It is a drop-in replacement for the Plugin SDK v2 helper/retry.StateChangeConf.
+type StateChangeConf struct {
+	Delay          time.Duration    // Wait this time before starting checks
+	Pending        []string         // States that are "allowed" and will continue trying
+	Refresh        StateRefreshFunc // Refreshes the current state
+	Target         []string         // Target state
+	Timeout        time.Duration    // The amount of time to wait before timeout
+	MinTimeout     time.Duration    // Smallest time to wait before refreshes
+	PollInterval   time.Duration    // Override MinTimeout/backoff and only poll this often
+	NotFoundChecks int              // Number of times to allow not found (nil result from Refresh)
+
+	// This is to work around inconsistent APIs
+	ContinuousTargetOccurence int // Number of times the Target state has to occur continuously
+
+	OnProgress ProgressFunc[string] // Called after each refresh that doesn't complete the state change
+}
+
+// WaitForStateContext watches an object and waits for it to achieve the state
+// specified in the configuration using the specified Refresh() func,
+// waiting the number of seconds specified in the timeout configuration.
+//
+// If the Refresh function returns an error, exit immediately with that error.
+//
+// If the Refresh function returns a state other than the Target state or one
+// listed in Pending, return immediately with an error.
+//
+// If the Timeout is exceeded before reaching the Target state, return an
+// error.
+//
+// Otherwise, the result is the result of the first call to the Refresh function to
+// reach the target state.
+//
+// Cancellation from the passed in context will cancel the refresh loop.
+//
+// As with the Plugin SDK v2 helper/retry.StateChangeConf, only a nil interface value
+// returned by the Refresh function represents not found and no result is returned
+// if the wait times out or is cancelled.
+func (conf *StateChangeConf) WaitForStateContext(ctx context.Context) (any, error) {
+	c := &StateChangeConfOf[any, string]{
+		Delay:   conf.Delay,
+		Pending: conf.Pending,
+		Refresh: func(context.Context) (any, string, error) {
+			return conf.Refresh()
+		},
+		Target:                    conf.Target,
+		Timeout:                   conf.Timeout,
+		MinTimeout:                conf.MinTimeout,
+		PollInterval:              conf.PollInterval,
+		NotFoundChecks:            conf.NotFoundChecks,
+		ContinuousTargetOccurence: conf.ContinuousTargetOccurence,
+		OnProgress:                conf.OnProgress,
+		isNil: func(v any) bool {
+			return v == nil
+		},
+	}
+
+	result, err := c.WaitForStateContext(ctx)
+
+	if _, ok := err.(*TimeoutError); ok || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) { //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
+		return nil, err
+	}
+
+	return result, err
+}
+
+// WaitForState is the equivalent of WaitForStateContext using a background context.
+//
+// Deprecated: Use WaitForStateContext to ensure proper plugin shutdown.
+func (conf *StateChangeConf) WaitForState() (any, error) {
+	return conf.WaitForStateContext(context.Background())
+}
+
+// StateChangeConfOf is the generic equivalent of StateChangeConf.
+// The result of the refresh function is returned without the need for a type assertion.
+type StateChangeConfOf[T any, S ~string] struct {
+	Delay          time.Duration            // Wait this time before starting checks
+	Pending        []S                      // States that are "allowed" and will continue trying
+	Refresh        StateRefreshFuncOf[T, S] // Refreshes the current state
+	Target         []S                      // Target state
+	Timeout        time.Duration            // The amount of time to wait before timeout
+	MinTimeout     time.Duration            // Smallest time to wait before refreshes
+	PollInterval   time.Duration            // Override MinTimeout/backoff and only poll this often
+	NotFoundChecks int                      // Number of times to allow not found (nil result from Refresh)
+
+	// This is to work around inconsistent APIs
+	ContinuousTargetOccurence int // Number of times the Target state has to occur continuously
+
+	OnProgress ProgressFunc[S] // Called after each refresh that doesn't complete the state change
+
+	isNil func(T) bool // Reports whether a refresh result represents not found
+}
+
+const (
+	defaultNotFoundChecks = 20
+	initialRefreshWait    = 100 * time.Millisecond
+	maxRefreshWait        = 10 * time.Second
+	maxPollInterval       = 180 * time.Second
+)
+
+// WaitForStateContext is the generic equivalent of StateChangeConf.WaitForStateContext.
+func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
+	var zero T
+
+	start := time.Now()
+	parent := ctx
+	ctx, cancel := context.WithTimeout(ctx, conf.Timeout)
+	defer cancel()
+
+	notFoundChecks := conf.NotFoundChecks
+	if notFoundChecks == 0 {
+		notFoundChecks = defaultNotFoundChecks
+	}
+
+	continuousTargetOccurence := conf.ContinuousTargetOccurence
+	if continuousTargetOccurence == 0 {
+		continuousTargetOccurence = 1
+	}
+
+	var (
+		result          T
+		state           S
+		refreshes       int
+		notFoundTick    int
+		targetOccurence int
+	)
+
+	// done returns the error to return once the context is done.
+	done := func() error {
+		if err := parent.Err(); err != nil {
+			return err
+		}
+
+		return &TimeoutError{
+			LastState:     string(state),
+			Timeout:       conf.Timeout,
+			ExpectedState: toStrings(conf.Target),
+		}
+	}
+
+	isNil := conf.isNil
+	if isNil == nil {
+		isNil = func(v T) bool {
+			return isNilValue(v)
+		}
+	}
+
+	logger := newProgressLogger(conf.Target)
+
+	if conf.Delay > 0 {
+		sleep(ctx, conf.Delay)
+	}
+
+	for wait := initialRefreshWait; ; {
+		if ctx.Err() != nil {
+			return result, done()
+		}
+
+		v, s, err := conf.Refresh(ctx)
+		refreshes++
+
+		if err != nil {
+			// The refresh was interrupted by the context becoming done.
+			if ctx.Err() != nil {
+				return result, done()
+			}
+
+			return v, err
+		}
+
+		result, state = v, s
+
+		if isNil(result) {
+			// If we're waiting for the absence of a thing, then return.
+			if len(conf.Target) == 0 {
+				targetOccurence++
+				if targetOccurence >= continuousTargetOccurence {
+					return zero, nil
+				}
+			} else {
+				// If we didn't find the resource, check if we have been
+				// not finding it for a while, and if so, report an error.
+				notFoundTick++
+				if notFoundTick > notFoundChecks {
+					return zero, &NotFoundError{Retries: notFoundTick}
+				}
+			}
+		} else {
+			notFoundTick = 0
+
+			switch {
+			case slices.Contains(conf.Target, state):
+				targetOccurence++
+				if targetOccurence >= continuousTargetOccurence {
+					return result, nil
+				}
+			case slices.Contains(conf.Pending, state):
+				// The target state must occur continuously.
+				targetOccurence = 0
+			case len(conf.Pending) == 0:
+				// Without any pending states, any state other than the target state is allowed.
+			default:
+				return result, &UnexpectedStateError{
+					State:         string(state),
+					ExpectedState: toStrings(conf.Target),
+				}
+			}
+		}
+
+		var remaining time.Duration
+		if deadline, ok := ctx.Deadline(); ok {
+			remaining = max(time.Until(deadline), 0)
+		}
+		elapsed := time.Since(start)
+
+		logger.log(ctx, string(state), refreshes, elapsed, remaining)
+
+		if conf.OnProgress != nil {
+			conf.OnProgress(ctx, Progress[S]{
+				State:     state,
+				Refreshes: refreshes,
+				Elapsed:   elapsed,
+				Remaining: remaining,
+			})
+		}
+
+		// Wait between refreshes using exponential backoff, except when
+		// waiting for the target state to reoccur.
+		if targetOccurence == 0 {
+			wait *= 2
+		}
+
+		// If a poll interval has been specified, choose that interval.
+		// Otherwise bound the default value.
+		if conf.PollInterval > 0 && conf.PollInterval < maxPollInterval {
+			wait = conf.PollInterval
+		} else {
+			if wait < conf.MinTimeout {
+				wait = conf.MinTimeout
+			} else if wait > maxRefreshWait {
+				wait = maxRefreshWait
+			}
+		}
+
+		sleep(ctx, wait)
+	}
+}
+
+// isNilValue returns whether the specified value is nil or a nil pointer, map, slice, etc.
+func isNilValue(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	switch v := reflect.ValueOf(v); v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+func toStrings[S ~string](states []S) []string {
+	if states == nil {
+		return nil
+	}
+
+	v := make([]string, len(states))
+	for i, s := range states {
+		v[i] = string(s)
+	}
+
+	return v
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStateChangeConfWaitForStateContext(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test")
+
+	testCases := []struct {
+		name           string
+		states         []string // Successive states returned by Refresh. "" represents not found.
+		err            error    // Returned by Refresh after all states.
+		pending        []string
+		target         []string
+		notFoundChecks int
+		continuous     int
+		wantResult     bool
+		wantErr        func(error) bool
+	}{
+		{
+			name:       "target",
+			states:     []string{"done"},
+			pending:    []string{"pending"},
+			target:     []string{"done"},
+			wantResult: true,
+		},
+		{
+			name:       "pending then target",
+			states:     []string{"pending", "pending", "done"},
+			pending:    []string{"pending"},
+			target:     []string{"done"},
+			wantResult: true,
+		},
+		{
+			name:    "unexpected state",
+			states:  []string{"pending", "failed"},
+			pending: []string{"pending"},
+			target:  []string{"done"},
+			wantErr: func(err error) bool {
+				var e *UnexpectedStateError
+				return errors.As(err, &e) && e.State == "failed"
+			},
+		},
+		{
+			name:    "refresh error",
+			states:  []string{"pending"},
+			err:     testErr,
+			pending: []string{"pending"},
+			target:  []string{"done"},
+			wantErr: func(err error) bool {
+				return errors.Is(err, testErr)
+			},
+		},
+		{
+			name:    "timeout",
+			states:  []string{"pending"},
+			pending: []string{"pending"},
+			target:  []string{"done"},
+			wantErr: TimedOut,
+		},
+		{
+			name:       "no pending states",
+			states:     []string{"creating", "updating", "done"},
+			target:     []string{"done"},
+			wantResult: true,
+		},
+		{
+			name:       "not found then target",
+			states:     []string{"", "", "done"},
+			pending:    []string{"pending"},
+			target:     []string{"done"},
+			wantResult: true,
+		},
+		{
+			name:           "not found checks exceeded",
+			states:         []string{"", "", "", "done"},
+			pending:        []string{"pending"},
+			target:         []string{"done"},
+			notFoundChecks: 2,
+			wantErr:        NotFound,
+		},
+		{
+			name:    "deleted",
+			states:  []string{"deleting", "deleting", ""},
+			pending: []string{"deleting"},
+		},
+		{
+			name:       "continuous target occurence",
+			states:     []string{"done", "pending", "done", "done"},
+			pending:    []string{"pending"},
+			target:     []string{"done"},
+			continuous: 2,
+			wantResult: true,
+		},
+		{
+			name:       "continuous target occurence timeout",
+			states:     []string{"done", "pending"},
+			pending:    []string{"pending"},
+			target:     []string{"done"},
+			continuous: 2,
+			wantErr: func(err error) bool {
+				var e *TimeoutError
+				return errors.As(err, &e) && e.LastState == "pending"
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var refreshes int
+			conf := &StateChangeConf{
+				Pending: testCase.pending,
+				Target:  testCase.target,
+				Refresh: func() (any, string, error) {
+					i := refreshes
+					refreshes++
+
+					if i >= len(testCase.states) {
+						if testCase.err != nil {
+							return nil, "", testCase.err
+						}
+						// Repeat the last state.
+						i = len(testCase.states) - 1
+					}
+
+					if state := testCase.states[i]; state != "" {
+						return state, state, nil
+					}
+
+					return nil, "", nil
+				},
+				Timeout:                   250 * time.Millisecond,
+				PollInterval:              time.Millisecond,
+				NotFoundChecks:            testCase.notFoundChecks,
+				ContinuousTargetOccurence: testCase.continuous,
+			}
+
+			got, err := conf.WaitForStateContext(context.Background())
+
+			if testCase.wantErr != nil {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !testCase.wantErr(err) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotResult := got != nil; gotResult != testCase.wantResult {
+				t.Errorf("got result %t, expected %t", gotResult, testCase.wantResult)
+			}
+		})
+	}
+}
+
+func TestStateChangeConfOfWaitForStateContext(t *testing.T) {
+	t.Parallel()
+
+	type status string
+
+	type widget struct {
+		Status status
+	}
+
+	var (
+		refreshes int
+		progress  []Progress[status]
+	)
+	conf := &StateChangeConfOf[*widget, status]{
+		Pending: []status{"CREATING"},
+		Target:  []status{"ACTIVE"},
+		Refresh: func(context.Context) (*widget, status, error) {
+			refreshes++
+
+			switch refreshes {
+			case 1:
+				// A nil pointer represents not found.
+				return nil, "", nil
+			case 2, 3:
+				return &widget{Status: "CREATING"}, "CREATING", nil
+			default:
+				return &widget{Status: "ACTIVE"}, "ACTIVE", nil
+			}
+		},
+		Timeout:      time.Minute,
+		PollInterval: time.Millisecond,
+		OnProgress: func(_ context.Context, p Progress[status]) {
+			progress = append(progress, p)
+		},
+	}
+
+	got, err := conf.WaitForStateContext(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Status != "ACTIVE" {
+		t.Fatalf("unexpected result: %v", got)
+	}
+
+	if got, want := len(progress), 3; got != want {
+		t.Fatalf("got %d progress reports, expected %d", got, want)
+	}
+
+	for i, p := range progress {
+		if got, want := p.Refreshes, i+1; got != want {
+			t.Errorf("progress %d: got %d refreshes, expected %d", i, got, want)
+		}
+		if p.Remaining <= 0 || p.Remaining > time.Minute {
+			t.Errorf("progress %d: unexpected remaining time %s", i, p.Remaining)
+		}
+	}
+
+	if got, want := progress[2].State, status("CREATING"); got != want {
+		t.Errorf("got state %q, expected %q", got, want)
+	}
+}
+
+func TestStateChangeConfWaitForStateContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	conf := &StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (any, string, error) {
+			return "pending", "pending", nil
+		},
+		Timeout: time.Minute,
+	}
+
+	_, err := conf.WaitForStateContext(ctx)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestStateChangeConfWaitForStateContextTimeout(t *testing.T) {
+	t.Parallel()
+
+	conf := &StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (any, string, error) {
+			return "pending", "pending", nil
+		},
+		Timeout:      50 * time.Millisecond,
+		PollInterval: time.Millisecond,
+	}
+
+	got, err := conf.WaitForStateContext(context.Background())
+
+	if !TimedOut(err) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("unexpected result: %v", got)
+	}
+}
+
+func TestStateChangeConfWaitForStateContextTypedNil(t *testing.T) {
+	t.Parallel()
+
+	type widget struct{}
+
+	conf := &StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (any, string, error) {
+			// Only a nil interface value represents not found.
+			return (*widget)(nil), "done", nil
+		},
+		Timeout:      time.Minute,
+		PollInterval: time.Millisecond,
+	}
+
+	got, err := conf.WaitForStateContext(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := got.(*widget); !ok {
+		t.Errorf("unexpected result: %v", got)
+	}
+}
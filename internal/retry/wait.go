@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// RetryFunc is the function retried until it succeeds.
+type RetryFunc func() *RetryError
+
+// RetryError is the required return type of RetryFunc. It forces client code
+// to choose whether or not a given error is retryable.
+// It is the equivalent of the Plugin SDK v2 helper/retry.RetryError.
+type RetryError struct {
+	Err       error
+	Retryable bool
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// RetryableError is a helper to create a RetryError that's retryable from a
+// given error. To prevent logic errors, will return an error when passed a
+// nil error.
+func RetryableError(err error) *RetryError {
+	if err == nil {
+		return &RetryError{
+			Err: errors.New("empty retryable error received. " +
+				"This is a bug with the Terraform provider and should be " +
+				"reported as a GitHub issue in the provider repository."),
+			Retryable: false,
+		}
+	}
+
+	return &RetryError{Err: err, Retryable: true}
+}
+
+// NonRetryableError is a helper to create a RetryError that's _not_ retryable
+// from a given error. To prevent logic errors, will return an error when
+// passed a nil error.
+func NonRetryableError(err error) *RetryError {
+	if err == nil {
+		return &RetryError{
+			Err: errors.New("empty non-retryable error received. " +
+				"This is a bug with the Terraform provider and should be " +
+				"reported as a GitHub issue in the provider repository."),
+			Retryable: false,
+		}
+	}
+
+	return &RetryError{Err: err, Retryable: false}
+}
+
+const (
+	retryStateRetryable = "retryableerror"
+	retryStateSuccess   = "success"
+)
+
+// RetryContext is a basic wrapper around StateChangeConf that will just retry
+// a function until it no longer returns an error.
+//
+// Cancellation from the passed in context will propagate through to the
+// underlying StateChangeConf.
+func RetryContext(ctx context.Context, timeout time.Duration, f RetryFunc) error {
+	return RetryContextWithConf(ctx, &StateChangeConf{
+		Timeout:    timeout,
+		MinTimeout: 500 * time.Millisecond,
+	}, f)
+}
+
+// RetryContextWithConf is the equivalent of RetryContext using the delay and poll intervals of the specified StateChangeConf.
+// The Pending, Target and Refresh fields are set by RetryContextWithConf.
+func RetryContextWithConf(ctx context.Context, conf *StateChangeConf, f RetryFunc) error {
+	// These are used to pull the error out of the function; need a mutex to
+	// avoid a data race.
+	var resultErr error
+	var resultErrMu sync.Mutex
+
+	conf.Pending = []string{retryStateRetryable}
+	conf.Target = []string{retryStateSuccess}
+	conf.Refresh = func() (any, string, error) {
+		rerr := f()
+
+		resultErrMu.Lock()
+		defer resultErrMu.Unlock()
+
+		if rerr == nil {
+			resultErr = nil
+			return 42, retryStateSuccess, nil
+		}
+
+		resultErr = rerr.Err
+
+		if rerr.Retryable {
+			return 42, retryStateRetryable, nil
+		}
+
+		return nil, "quit", rerr.Err
+	}
+
+	_, waitErr := conf.WaitForStateContext(ctx)
+
+	// Need to acquire the lock here to be able to avoid race using resultErr as
+	// the return value
+	resultErrMu.Lock()
+	defer resultErrMu.Unlock()
+
+	// resultErr may be nil because the wait timed out and resultErr was never
+	// set; this is still an error
+	if resultErr == nil {
+		return waitErr
+	}
+
+	// resultErr takes precedence over waitErr if both are set because it is
+	// more likely to be useful
+	return resultErr
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryContext(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test")
+
+	testCases := []struct {
+		name      string
+		f         func(int) *RetryError
+		wantCalls int
+		wantErr   func(error) bool
+	}{
+		{
+			name: "success",
+			f: func(int) *RetryError {
+				return nil
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "retryable then success",
+			f: func(i int) *RetryError {
+				if i < 3 {
+					return RetryableError(testErr)
+				}
+				return nil
+			},
+			wantCalls: 3,
+		},
+		{
+			name: "non-retryable",
+			f: func(int) *RetryError {
+				return NonRetryableError(testErr)
+			},
+			wantCalls: 1,
+			wantErr: func(err error) bool {
+				return errors.Is(err, testErr)
+			},
+		},
+		{
+			name: "timeout",
+			f: func(int) *RetryError {
+				return RetryableError(testErr)
+			},
+			wantErr: func(err error) bool {
+				// The last error takes precedence over the timeout.
+				return errors.Is(err, testErr)
+			},
+		},
+		{
+			name: "nil retryable error",
+			f: func(int) *RetryError {
+				return RetryableError(nil)
+			},
+			wantCalls: 1,
+			wantErr: func(err error) bool {
+				return err != nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			err := RetryContextWithConf(context.Background(), &StateChangeConf{
+				Timeout:      250 * time.Millisecond,
+				PollInterval: time.Millisecond,
+			}, func() *RetryError {
+				calls++
+				return testCase.f(calls)
+			})
+
+			if testCase.wantErr != nil {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !testCase.wantErr(err) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.wantCalls > 0 && calls != testCase.wantCalls {
+				t.Errorf("got %d calls, expected %d", calls, testCase.wantCalls)
+			}
+		})
+	}
+}
@@ -8,8 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"time"
-
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 type Op[T any] interface {
@@ -63,7 +61,7 @@ func (o operation[T]) If(predicate PredicateFunc[T]) operation[T] {
 	return o.withPredicate(predicate)
 }
 
-// UntilFoundN retries an operation if it returns a retry.NotFoundError.
+// UntilFoundN retries an operation if it returns a NotFoundError.
 func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 	if continuousTargetOccurence < 1 {
 		continuousTargetOccurence = 1
@@ -82,7 +80,7 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) {
+		if NotFound(err) {
 			targetOccurence = 0
 
 			return true, err
@@ -100,7 +98,7 @@ func (o operation[T]) UntilNotFound() operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) {
+		if NotFound(err) {
 			return false, nil
 		}
 
@@ -22,13 +22,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -169,27 +169,6 @@ func (r *agentActionGroupResource) Create(ctx context.Context, request resource.
 		return
 	}
 
-	// AutoFlEx doesn't yet handle union types.
-	if !data.ActionGroupExecutor.IsNull() {
-		actionGroupExecutorData, diags := data.ActionGroupExecutor.ToPtr(ctx)
-		response.Diagnostics.Append(diags...)
-		if response.Diagnostics.HasError() {
-			return
-		}
-
-		input.ActionGroupExecutor = expandActionGroupExecutor(ctx, actionGroupExecutorData)
-	}
-
-	if !data.APISchema.IsNull() {
-		apiSchemaData, diags := data.APISchema.ToPtr(ctx)
-		response.Diagnostics.Append(diags...)
-		if response.Diagnostics.HasError() {
-			return
-		}
-
-		input.ApiSchema = expandAPISchema(ctx, apiSchemaData)
-	}
-
 	output, err := conn.CreateAgentActionGroup(ctx, input)
 
 	if err != nil {
@@ -241,10 +220,6 @@ func (r *agentActionGroupResource) Read(ctx context.Context, request resource.Re
 		return
 	}
 
-	// AutoFlEx doesn't yet handle union types.
-	data.ActionGroupExecutor = flattenActionGroupExecutor(ctx, output.ActionGroupExecutor)
-	data.APISchema = flattenAPISchema(ctx, output.ApiSchema)
-
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -273,27 +248,6 @@ func (r *agentActionGroupResource) Update(ctx context.Context, request resource.
 			return
 		}
 
-		// AutoFlEx doesn't yet handle union types.
-		if !new.ActionGroupExecutor.IsNull() {
-			actionGroupExecutorData, diags := new.ActionGroupExecutor.ToPtr(ctx)
-			response.Diagnostics.Append(diags...)
-			if response.Diagnostics.HasError() {
-				return
-			}
-
-			input.ActionGroupExecutor = expandActionGroupExecutor(ctx, actionGroupExecutorData)
-		}
-
-		if !new.APISchema.IsNull() {
-			apiSchemaData, diags := new.APISchema.ToPtr(ctx)
-			response.Diagnostics.Append(diags...)
-			if response.Diagnostics.HasError() {
-				return
-			}
-
-			input.ApiSchema = expandAPISchema(ctx, apiSchemaData)
-		}
-
 		_, err := conn.UpdateAgentActionGroup(ctx, input)
 
 		if err != nil {
@@ -420,71 +374,7 @@ type s3IdentifierModel struct {
 	S3ObjectKey  types.String `tfsdk:"s3_object_key"`
 }
 
-func expandActionGroupExecutor(_ context.Context, actionGroupExecutorData *actionGroupExecutorModel) awstypes.ActionGroupExecutor {
-	if !actionGroupExecutorData.Lambda.IsNull() {
-		return &awstypes.ActionGroupExecutorMemberLambda{
-			Value: actionGroupExecutorData.Lambda.ValueString(),
-		}
-	}
-
-	return nil
-}
-
-func flattenActionGroupExecutor(ctx context.Context, apiObject awstypes.ActionGroupExecutor) fwtypes.ListNestedObjectValueOf[actionGroupExecutorModel] {
-	if apiObject == nil {
-		return fwtypes.NewListNestedObjectValueOfNull[actionGroupExecutorModel](ctx)
-	}
-
-	var actionGroupExecutorData actionGroupExecutorModel
-
-	switch v := apiObject.(type) {
-	case *awstypes.ActionGroupExecutorMemberLambda:
-		actionGroupExecutorData.Lambda = fwtypes.ARNValue(v.Value)
-	}
-
-	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &actionGroupExecutorData)
-}
-
-func expandAPISchema(ctx context.Context, apiSchemaData *apiSchemaModel) awstypes.APISchema {
-	if !apiSchemaData.Payload.IsNull() {
-		return &awstypes.APISchemaMemberPayload{
-			Value: apiSchemaData.Payload.ValueString(),
-		}
-	}
-
-	if !apiSchemaData.S3.IsNull() {
-		s3IdentifierModel := fwdiag.Must(apiSchemaData.S3.ToPtr(ctx))
-
-		return &awstypes.APISchemaMemberS3{
-			Value: awstypes.S3Identifier{
-				S3BucketName: fwflex.StringFromFramework(ctx, s3IdentifierModel.S3BucketName),
-				S3ObjectKey:  fwflex.StringFromFramework(ctx, s3IdentifierModel.S3ObjectKey),
-			},
-		}
-	}
-
-	return nil
-}
-
-func flattenAPISchema(ctx context.Context, apiObject awstypes.APISchema) fwtypes.ListNestedObjectValueOf[apiSchemaModel] {
-	if apiObject == nil {
-		return fwtypes.NewListNestedObjectValueOfNull[apiSchemaModel](ctx)
-	}
-
-	var apiSchemaData apiSchemaModel
-
-	switch v := apiObject.(type) {
-	case *awstypes.APISchemaMemberPayload:
-		apiSchemaData.Payload = fwflex.StringValueToFramework(ctx, v.Value)
-		apiSchemaData.S3 = fwtypes.NewListNestedObjectValueOfNull[s3IdentifierModel](ctx)
-
-	case *awstypes.APISchemaMemberS3:
-		apiSchemaData.Payload = types.StringNull()
-		apiSchemaData.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3IdentifierModel{
-			S3BucketName: fwflex.StringToFramework(ctx, v.Value.S3BucketName),
-			S3ObjectKey:  fwflex.StringToFramework(ctx, v.Value.S3ObjectKey),
-		})
-	}
-
-	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &apiSchemaData)
+func init() {
+	fwflex.RegisterUnionMembers[awstypes.ActionGroupExecutor](&awstypes.ActionGroupExecutorMemberLambda{})
+	fwflex.RegisterUnionMembers[awstypes.APISchema](&awstypes.APISchemaMemberPayload{}, &awstypes.APISchemaMemberS3{})
 }
@@ -14,7 +14,6 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -22,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -860,7 +860,7 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 	const (
 		timeout = 1 * time.Minute
 	)
-	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.InvalidViewerCertificate](ctx, timeout, func() (interface{}, error) {
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.InvalidViewerCertificate](ctx, meta.(*conns.AWSClient).ScaleTimeout(timeout), func() (interface{}, error) {
 		return conn.CreateDistributionWithTags(ctx, input)
 	})
 
@@ -871,7 +871,7 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 	d.SetId(aws.ToString(outputRaw.(*cloudfront.CreateDistributionWithTagsOutput).Distribution.Id))
 
 	if d.Get("wait_for_deployment").(bool) {
-		if _, err := waitDistributionDeployed(ctx, conn, d.Id()); err != nil {
+		if _, err := waitDistributionDeployed(ctx, conn, d.Id(), meta.(*conns.AWSClient).ScaleTimeout(distributionWaitTimeout)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution (%s) deploy: %s", d.Id(), err)
 		}
 	}
@@ -987,7 +987,7 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 		const (
 			timeout = 1 * time.Minute
 		)
-		_, err := tfresource.RetryWhenIsA[*awstypes.InvalidViewerCertificate](ctx, timeout, func() (interface{}, error) {
+		_, err := tfresource.RetryWhenIsA[*awstypes.InvalidViewerCertificate](ctx, meta.(*conns.AWSClient).ScaleTimeout(timeout), func() (interface{}, error) {
 			return conn.UpdateDistribution(ctx, input)
 		})
 
@@ -1010,7 +1010,7 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		if d.Get("wait_for_deployment").(bool) {
-			if _, err := waitDistributionDeployed(ctx, conn, d.Id()); err != nil {
+			if _, err := waitDistributionDeployed(ctx, conn, d.Id(), meta.(*conns.AWSClient).ScaleTimeout(distributionWaitTimeout)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution (%s) deploy: %s", d.Id(), err)
 			}
 		}
@@ -1022,6 +1022,7 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+	waitTimeout := meta.(*conns.AWSClient).ScaleTimeout(distributionWaitTimeout)
 
 	if d.Get(names.AttrARN).(string) == "" {
 		diags = append(diags, resourceDistributionRead(ctx, d, meta)...)
@@ -1035,13 +1036,13 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		case err != nil:
 			return sdkdiag.AppendFromErr(diags, err)
 		default:
-			if _, err := waitDistributionDeployed(ctx, conn, d.Id()); err != nil && !tfresource.NotFound(err) {
+			if _, err := waitDistributionDeployed(ctx, conn, d.Id(), waitTimeout); err != nil && !tfresource.NotFound(err) {
 				return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution (%s) deploy: %s", d.Id(), err)
 			}
 		}
 	}
 
-	if err := disableDistribution(ctx, conn, d.Id()); err != nil {
+	if err := disableDistribution(ctx, conn, d.Id(), waitTimeout); err != nil {
 		if tfresource.NotFound(err) {
 			return diags
 		}
@@ -1054,7 +1055,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
-	err := deleteDistribution(ctx, conn, d.Id())
+	err := deleteDistribution(ctx, conn, d.Id(), waitTimeout)
 
 	if err == nil || tfresource.NotFound(err) || errs.IsA[*awstypes.NoSuchDistribution](err) {
 		return diags
@@ -1064,7 +1065,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 	// Here we update via the deployed configuration to ensure we are not submitting an out of date
 	// configuration from the Terraform configuration, should other changes have occurred manually.
 	if errs.IsA[*awstypes.DistributionNotDisabled](err) {
-		if err := disableDistribution(ctx, conn, d.Id()); err != nil {
+		if err := disableDistribution(ctx, conn, d.Id(), waitTimeout); err != nil {
 			if tfresource.NotFound(err) {
 				return diags
 			}
@@ -1075,8 +1076,8 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		const (
 			timeout = 3 * time.Minute
 		)
-		_, err = tfresource.RetryWhenIsA[*awstypes.DistributionNotDisabled](ctx, timeout, func() (interface{}, error) {
-			return nil, deleteDistribution(ctx, conn, d.Id())
+		_, err = tfresource.RetryWhenIsA[*awstypes.DistributionNotDisabled](ctx, meta.(*conns.AWSClient).ScaleTimeout(timeout), func() (interface{}, error) {
+			return nil, deleteDistribution(ctx, conn, d.Id(), waitTimeout)
 		})
 	}
 
@@ -1084,13 +1085,13 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		const (
 			timeout = 1 * time.Minute
 		)
-		_, err = tfresource.RetryWhenIsOneOf2[*awstypes.PreconditionFailed, *awstypes.InvalidIfMatchVersion](ctx, timeout, func() (interface{}, error) {
-			return nil, deleteDistribution(ctx, conn, d.Id())
+		_, err = tfresource.RetryWhenIsOneOf2[*awstypes.PreconditionFailed, *awstypes.InvalidIfMatchVersion](ctx, meta.(*conns.AWSClient).ScaleTimeout(timeout), func() (interface{}, error) {
+			return nil, deleteDistribution(ctx, conn, d.Id(), waitTimeout)
 		})
 	}
 
 	if errs.IsA[*awstypes.DistributionNotDisabled](err) {
-		if err := disableDistribution(ctx, conn, d.Id()); err != nil {
+		if err := disableDistribution(ctx, conn, d.Id(), waitTimeout); err != nil {
 			if tfresource.NotFound(err) {
 				return diags
 			}
@@ -1098,7 +1099,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
-		err = deleteDistribution(ctx, conn, d.Id())
+		err = deleteDistribution(ctx, conn, d.Id(), waitTimeout)
 	}
 
 	if errs.IsA[*awstypes.NoSuchDistribution](err) { // nosemgrep:dgryski.semgrep-go.oddifsequence.odd-sequence-ifs
@@ -1112,7 +1113,7 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
-func deleteDistribution(ctx context.Context, conn *cloudfront.Client, id string) error {
+func deleteDistribution(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) error {
 	etag, err := distroETag(ctx, conn, id)
 
 	if err != nil {
@@ -1130,7 +1131,7 @@ func deleteDistribution(ctx context.Context, conn *cloudfront.Client, id string)
 		return fmt.Errorf("deleting CloudFront Distribution (%s): %w", id, err)
 	}
 
-	if _, err := waitDistributionDeleted(ctx, conn, id); err != nil {
+	if _, err := waitDistributionDeleted(ctx, conn, id, timeout); err != nil {
 		return fmt.Errorf("waiting for CloudFront Distribution (%s) delete: %w", id, err)
 	}
 
@@ -1147,7 +1148,7 @@ func distroETag(ctx context.Context, conn *cloudfront.Client, id string) (string
 	return aws.ToString(output.ETag), nil
 }
 
-func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string) error {
+func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) error {
 	output, err := findDistributionByID(ctx, conn, id)
 
 	if err != nil {
@@ -1155,7 +1156,7 @@ func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string
 	}
 
 	if aws.ToString(output.Distribution.Status) == distributionStatusInProgress {
-		output, err = waitDistributionDeployed(ctx, conn, id)
+		output, err = waitDistributionDeployed(ctx, conn, id, timeout)
 
 		if err != nil {
 			return fmt.Errorf("waiting for CloudFront Distribution (%s) deploy: %w", id, err)
@@ -1179,7 +1180,7 @@ func disableDistribution(ctx context.Context, conn *cloudfront.Client, id string
 		return fmt.Errorf("updating CloudFront Distribution (%s): %w", id, err)
 	}
 
-	if _, err := waitDistributionDeployed(ctx, conn, id); err != nil {
+	if _, err := waitDistributionDeployed(ctx, conn, id, timeout); err != nil {
 		return fmt.Errorf("waiting for CloudFront Distribution (%s) deploy: %w", id, err)
 	}
 
@@ -1231,12 +1232,16 @@ func statusDistribution(ctx context.Context, conn *cloudfront.Client, id string)
 	}
 }
 
-func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionOutput, error) {
+const (
+	distributionWaitTimeout = 90 * time.Minute
+)
+
+func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetDistributionOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{distributionStatusInProgress},
 		Target:     []string{distributionStatusDeployed},
 		Refresh:    statusDistribution(ctx, conn, id),
-		Timeout:    90 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 15 * time.Second,
 		Delay:      30 * time.Second,
 	}
@@ -1250,12 +1255,12 @@ func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id s
 	return nil, err
 }
 
-func waitDistributionDeleted(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionOutput, error) {
+func waitDistributionDeleted(ctx context.Context, conn *cloudfront.Client, id string, timeout time.Duration) (*cloudfront.GetDistributionOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{distributionStatusInProgress, distributionStatusDeployed},
 		Target:     []string{},
 		Refresh:    statusDistribution(ctx, conn, id),
-		Timeout:    90 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 15 * time.Second,
 		Delay:      15 * time.Second,
 	}
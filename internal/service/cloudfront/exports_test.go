@@ -37,4 +37,6 @@ var (
 	FindRealtimeLogConfigByARN                 = findRealtimeLogConfigByARN
 	FindResponseHeadersPolicyByID              = findResponseHeadersPolicyByID
 	WaitDistributionDeployed                   = waitDistributionDeployed
+
+	DistributionWaitTimeout = distributionWaitTimeout
 )
@@ -4,80 +4,66 @@
 package logs
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
-		Name: "aws_cloudwatch_log_group",
-		F:    sweepGroups,
-		Dependencies: []string{
-			"aws_api_gateway_rest_api",
-			"aws_cloudhsm_v2_cluster",
-			"aws_cloudtrail",
-			"aws_datasync_task",
-			"aws_db_instance",
-			"aws_directory_service_directory",
-			"aws_ec2_client_vpn_endpoint",
-			"aws_eks_cluster",
-			"aws_elasticsearch_domain",
-			"aws_flow_log",
-			"aws_glue_job",
-			"aws_kinesis_analytics_application",
-			"aws_kinesis_firehose_delivery_stream",
-			"aws_lambda_function",
-			"aws_mq_broker",
-			"aws_msk_cluster",
-			"aws_rds_cluster",
-			"aws_route53_query_log",
-			"aws_sagemaker_endpoint",
-			"aws_storagegateway_gateway",
-		},
-	})
-
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
-		Name: "aws_cloudwatch_query_definition",
-		F:    sweeplogQueryDefinitions,
-	})
-
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
-		Name: "aws_cloudwatch_log_resource_policy",
-		F:    sweepResourcePolicies,
-	})
+	sweep.Register("aws_cloudwatch_log_group", sweepGroups,
+		"aws_api_gateway_rest_api",
+		"aws_cloudhsm_v2_cluster",
+		"aws_cloudtrail",
+		"aws_datasync_task",
+		"aws_db_instance",
+		"aws_directory_service_directory",
+		"aws_ec2_client_vpn_endpoint",
+		"aws_eks_cluster",
+		"aws_elasticsearch_domain",
+		"aws_flow_log",
+		"aws_glue_job",
+		"aws_kinesis_analytics_application",
+		"aws_kinesis_firehose_delivery_stream",
+		"aws_lambda_function",
+		"aws_mq_broker",
+		"aws_msk_cluster",
+		"aws_rds_cluster",
+		"aws_route53_query_log",
+		"aws_sagemaker_endpoint",
+		"aws_storagegateway_gateway",
+	)
+
+	sweep.Register("aws_cloudwatch_query_definition", sweepQueryDefinitions)
+
+	sweep.Register("aws_cloudwatch_log_resource_policy", sweepResourcePolicies)
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("getting client: %s", err)
-	}
-	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+func sweepGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.LogsClient(ctx)
-	sweepResources := make([]sweep.Sweepable, 0)
 
-	pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+	var sweepResources []sweep.Sweepable
+	r := resourceGroup()
+
+	pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, &cloudwatchlogs.DescribeLogGroupsInput{})
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping CloudWatch Logs Log Group sweep for %s: %s", region, err)
-			return nil
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
 		}
-
 		if err != nil {
-			return fmt.Errorf("error listing CloudWatch Logs Log Groups (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.LogGroups {
-			r := resourceGroup()
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.LogGroupName))
 
@@ -85,32 +71,21 @@ func sweepGroups(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping CloudWatch Logs Log Groups (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
-func sweeplogQueryDefinitions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("getting client: %s", err)
-	}
-	input := &cloudwatchlogs.DescribeQueryDefinitionsInput{}
+func sweepQueryDefinitions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.LogsClient(ctx)
-	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeQueryDefinitionsPages(ctx, conn, input, func(page *cloudwatchlogs.DescribeQueryDefinitionsOutput, lastPage bool) bool {
+	var sweepResources []sweep.Sweepable
+	r := resourceQueryDefinition()
+
+	err := describeQueryDefinitionsPages(ctx, conn, &cloudwatchlogs.DescribeQueryDefinitionsInput{}, func(page *cloudwatchlogs.DescribeQueryDefinitionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, v := range page.QueryDefinitions {
-			r := resourceQueryDefinition()
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.QueryDefinitionId))
 
@@ -121,40 +96,30 @@ func sweeplogQueryDefinitions(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CloudWatch Logs Query Definition sweep for %s: %s", region, err)
-		return nil
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, nil
 	}
-
 	if err != nil {
-		return fmt.Errorf("error listing CloudWatch Logs Query Definitions (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping CloudWatch Logs Query Definitions (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
-func sweepResourcePolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("getting client: %s", err)
-	}
-	input := &cloudwatchlogs.DescribeResourcePoliciesInput{}
+func sweepResourcePolicies(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.LogsClient(ctx)
-	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeResourcePoliciesPages(ctx, conn, input, func(page *cloudwatchlogs.DescribeResourcePoliciesOutput, lastPage bool) bool {
+	var sweepResources []sweep.Sweepable
+	r := resourceResourcePolicy()
+
+	err := describeResourcePoliciesPages(ctx, conn, &cloudwatchlogs.DescribeResourcePoliciesInput{}, func(page *cloudwatchlogs.DescribeResourcePoliciesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, v := range page.ResourcePolicies {
-			r := resourceResourcePolicy()
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.PolicyName))
 
@@ -165,19 +130,14 @@ func sweepResourcePolicies(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CloudWatch Logs Resource Policy sweep for %s: %s", region, err)
-		return nil
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, nil
 	}
-
-	if err != nil {
-		return fmt.Errorf("error listing CloudWatch Logs Resource Policies (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping CloudWatch Logs Resource Policies (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2OriginEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediapackagev2", regexache.MustCompile(`channelGroup/.+/channel/.+/originEndpoint/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_mediapackagev2_channel.test", "channel_group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", "aws_mediapackagev2_channel.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "TS"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.encryption.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "segment.0.segment_duration_seconds"),
+					resource.TestCheckResourceAttrSet(resourceName, "segment.0.segment_name"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_cmaf(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_cmaf(rName, 6, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "CMAF"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.manifest_name", "dash"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.manifest_window_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.period_triggers.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "dash_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.0.manifest_name", "llhls"),
+					resource.TestCheckResourceAttrSet(resourceName, "low_latency_hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_cmaf(rName, 4, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.manifest_window_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagev2_origin_endpoint" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage v2 Origin Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointExists(ctx context.Context, n string, v *mediapackagev2.GetOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		output, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  segment {}
+
+  hls_manifest {
+    manifest_name = "index"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  segment {}
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccOriginEndpointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  segment {}
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccOriginEndpointConfig_cmaf(rName string, segmentDurationSeconds, manifestWindowSeconds int) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name       = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name             = aws_mediapackagev2_channel.test.name
+  name                     = %[1]q
+  container_type           = "CMAF"
+  startover_window_seconds = 300
+
+  segment {
+    segment_duration_seconds = %[2]d
+    segment_name             = "segment"
+
+    scte {
+      scte_filter = ["SPLICE_INSERT", "BREAK"]
+    }
+  }
+
+  hls_manifest {
+    manifest_name           = "index"
+    manifest_window_seconds = %[3]d
+
+    scte_hls {
+      ad_marker_hls = "DATERANGE"
+    }
+  }
+
+  low_latency_hls_manifest {
+    manifest_name           = "llhls"
+    manifest_window_seconds = %[3]d
+  }
+
+  dash_manifest {
+    manifest_name           = "dash"
+    manifest_window_seconds = %[3]d
+    min_buffer_time_seconds = 30
+    period_triggers         = ["AVAILS"]
+    segment_template_format = "NUMBER_WITH_TIMELINE"
+
+    scte_dash {
+      ad_marker_dash = "XML"
+    }
+
+    utc_timing {
+      timing_mode   = "HTTP_HEAD"
+      timing_source = "https://time.akamai.com"
+    }
+  }
+}
+`, rName, segmentDurationSeconds, manifestWindowSeconds))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	sweep.Register("aws_mediapackagev2_channel", sweepChannels,
+		"aws_mediapackagev2_origin_endpoint",
+	)
+	sweep.Register("aws_mediapackagev2_channel_group", sweepChannelGroups,
+		"aws_mediapackagev2_channel",
+	)
+	sweep.Register("aws_mediapackagev2_origin_endpoint", sweepOriginEndpoints)
+}
+
+func sweepChannelGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaPackageV2Client(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := mediapackagev2.NewListChannelGroupsPaginator(conn, &mediapackagev2.ListChannelGroupsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newChannelGroupResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ChannelGroupName)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepChannels(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaPackageV2Client(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := mediapackagev2.NewListChannelGroupsPaginator(conn, &mediapackagev2.ListChannelGroupsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			input := &mediapackagev2.ListChannelsInput{
+				ChannelGroupName: v.ChannelGroupName,
+			}
+
+			pages := mediapackagev2.NewListChannelsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Items {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newChannelResource, client,
+						framework.NewAttribute("channel_group_name", aws.ToString(v.ChannelGroupName)),
+						framework.NewAttribute(names.AttrName, aws.ToString(v.ChannelName)),
+					))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepOriginEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MediaPackageV2Client(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := mediapackagev2.NewListChannelGroupsPaginator(conn, &mediapackagev2.ListChannelGroupsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			input := &mediapackagev2.ListChannelsInput{
+				ChannelGroupName: v.ChannelGroupName,
+			}
+
+			pages := mediapackagev2.NewListChannelsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Items {
+					input := &mediapackagev2.ListOriginEndpointsInput{
+						ChannelGroupName: v.ChannelGroupName,
+						ChannelName:      v.ChannelName,
+					}
+
+					pages := mediapackagev2.NewListOriginEndpointsPaginator(conn, input)
+					for pages.HasMorePages() {
+						page, err := pages.NextPage(ctx)
+						if err != nil {
+							return nil, err
+						}
+
+						for _, v := range page.Items {
+							sweepResources = append(sweepResources, framework.NewSweepResource(newOriginEndpointResource, client,
+								framework.NewAttribute("channel_group_name", aws.ToString(v.ChannelGroupName)),
+								framework.NewAttribute("channel_name", aws.ToString(v.ChannelName)),
+								framework.NewAttribute(names.AttrName, aws.ToString(v.OriginEndpointName)),
+							))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	sweep.Register("aws_neptunegraph_graph", sweepGraphs,
+		"aws_neptunegraph_private_graph_endpoint",
+	)
+	sweep.Register("aws_neptunegraph_graph_snapshot", sweepGraphSnapshots)
+	sweep.Register("aws_neptunegraph_private_graph_endpoint", sweepPrivateGraphEndpoints)
+}
+
+func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, &neptunegraph.ListGraphsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Graphs {
+			id := aws.ToString(v.Id)
+
+			sweepResources = append(sweepResources, graphSweeper{
+				sweepResource: framework.NewSweepResource(newGraphResource, client,
+					framework.NewAttribute(names.AttrID, id),
+					framework.NewAttribute("skip_final_snapshot", true),
+				),
+				conn:               conn,
+				deletionProtection: aws.ToBool(v.DeletionProtection),
+				id:                 id,
+			})
+		}
+	}
+
+	return sweepResources, nil
+}
+
+type sweepResource interface {
+	sweep.Describer
+	sweep.Importable
+	sweep.Inspectable
+	sweep.Sweepable
+}
+
+// graphSweeper turns off a graph's deletion protection before deleting it.
+type graphSweeper struct {
+	sweepResource
+	conn               *neptunegraph.Client
+	deletionProtection bool
+	id                 string
+}
+
+func (gs graphSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	if gs.deletionProtection {
+		_, err := gs.conn.UpdateGraph(ctx, &neptunegraph.UpdateGraphInput{
+			DeletionProtection: aws.Bool(false),
+			GraphIdentifier:    aws.String(gs.id),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("disabling Neptune Analytics Graph (%s) deletion protection: %w", gs.id, err)
+		}
+
+		if _, err := waitGraphUpdated(ctx, gs.conn, gs.id, timeout); err != nil {
+			return fmt.Errorf("waiting for Neptune Analytics Graph (%s) update: %w", gs.id, err)
+		}
+	}
+
+	return gs.sweepResource.Delete(ctx, timeout, optFns...)
+}
+
+func sweepGraphSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphSnapshotsPaginator(conn, &neptunegraph.ListGraphSnapshotsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GraphSnapshots {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphSnapshotResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPrivateGraphEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, &neptunegraph.ListGraphsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Graphs {
+			graphID := aws.ToString(v.Id)
+			input := &neptunegraph.ListPrivateGraphEndpointsInput{
+				GraphIdentifier: aws.String(graphID),
+			}
+
+			pages := neptunegraph.NewListPrivateGraphEndpointsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.PrivateGraphEndpoints {
+					vpcID := aws.ToString(v.VpcId)
+
+					sweepResources = append(sweepResources, framework.NewSweepResource(newPrivateGraphEndpointResource, client,
+						framework.NewAttribute(names.AttrID, errs.Must(flex.FlattenResourceId([]string{graphID, vpcID}, privateGraphEndpointResourceIDPartCount, false))),
+						framework.NewAttribute("graph_id", graphID),
+						framework.NewAttribute(names.AttrVPCID, vpcID),
+					))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+)
+
+// getTagsInSlice returns route53profiles service tags from Context in the form expected by the Create* and Associate* APIs.
+// The tagging APIs use a map, the create APIs a slice of key-value pairs.
+// nil is returned if there are no input tags.
+func getTagsInSlice(ctx context.Context) []awstypes.Tag {
+	tags := getTagsIn(ctx)
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+// Exports for use in tests only.
+var (
+	ResourceDBInstance       = newDBInstanceResource
+	ResourceDBParameterGroup = newDBParameterGroupResource
+
+	FindDBInstanceByID       = findDBInstanceByID
+	FindDBParameterGroupByID = findDBParameterGroupByID
+)
@@ -9,6 +9,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
+// contextKeyType is the type of the key used to store sweeper information in Context.
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// inContext represents the sweeper information kept in Context.
+type inContext struct {
+	deleting bool // Set while SweepOrchestrator deletes a resource.
+	region   string
+	sweeper  string // Set for sweepers registered using Register.
+}
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -16,5 +28,36 @@ func Context(region string) context.Context {
 
 	ctx = logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, contextKey, &inContext{
+		region: region,
+	})
+
 	return ctx
 }
+
+func withSweeper(ctx context.Context, name string) context.Context {
+	v := inContext{
+		sweeper: name,
+	}
+	if info, ok := fromContext(ctx); ok {
+		v.region = info.region
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+// withDeleting marks Context as being used by SweepOrchestrator to delete a resource.
+func withDeleting(ctx context.Context) context.Context {
+	var v inContext
+	if info, ok := fromContext(ctx); ok {
+		v = *info
+	}
+	v.deleting = true
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+func fromContext(ctx context.Context) (*inContext, bool) {
+	v, ok := ctx.Value(contextKey).(*inContext)
+	return v, ok
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	flagSweepMinAge     = flag.Duration("sweep-min-age", 0, "Only sweep resources created at least this long ago, where a creation time exists")
+	flagSweepNamePrefix = flag.String("sweep-name-prefix", "", "Only sweep resources whose name starts with this prefix")
+	flagSweepNameRegex  = flag.String("sweep-name-regex", "", "Only sweep resources whose name matches this regular expression")
+	flagSweepTags       = flag.String("sweep-tags", "", "Comma-separated list of tags, as key or key=value, that swept resources must have")
+)
+
+var filter *Filter
+
+// Inspectable is implemented by Sweepables that can inspect the resource that they sweep so that it can be filtered.
+type Inspectable interface {
+	// Inspect reads the swept resource and returns the values of any of the specified top-level string attributes
+	// that are set, and the resource's tags.
+	// A NotFound error is returned if the resource no longer exists.
+	Inspect(ctx context.Context, attributes []string) (map[string]string, map[string]string, error)
+}
+
+// creationTimeAttributes are the names of attributes that typically hold a resource's creation time, in order of precedence.
+var creationTimeAttributes = []string{
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreateTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+	"create_date",
+	"created_timestamp",
+	"creation_timestamp",
+}
+
+// inspect returns the swept resource's name, tags and creation time, where known.
+// Resources without a name attribute are identified by ID.
+func inspect(ctx context.Context, inspectable Inspectable) (string, map[string]string, time.Time, error) {
+	attributes, tags, err := inspectable.Inspect(ctx, append([]string{names.AttrID, names.AttrName}, creationTimeAttributes...))
+	if err != nil {
+		return "", nil, time.Time{}, err
+	}
+
+	name := attributes[names.AttrName]
+	if name == "" {
+		name = attributes[names.AttrID]
+	}
+
+	var created time.Time
+	for _, attribute := range creationTimeAttributes {
+		if v, err := time.Parse(time.RFC3339, attributes[attribute]); err == nil {
+			created = v
+			break
+		}
+	}
+
+	return name, tags, created, nil
+}
+
+// Filter selects the resources that sweepers delete.
+// Resources whose Sweepables do not implement Inspectable are not deleted if any filter is set.
+type Filter struct {
+	// MinAge is the minimum age of swept resources. Resources with no known creation time are not filtered by age.
+	MinAge time.Duration
+	// NamePrefix is a prefix that the names of swept resources must start with.
+	NamePrefix string
+	// NameRegex is a regular expression that the names of swept resources must match.
+	NameRegex *regexp.Regexp
+	// Tags are the tags that swept resources must have. An empty value matches any tag value.
+	Tags map[string]string
+}
+
+// newFilterFromFlags returns a Filter configured from command line flags, or nil if no filter flags are set.
+func newFilterFromFlags() (*Filter, error) {
+	f := &Filter{
+		MinAge:     *flagSweepMinAge,
+		NamePrefix: *flagSweepNamePrefix,
+	}
+
+	if v := *flagSweepNameRegex; v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing -sweep-name-regex (%s): %w", v, err)
+		}
+		f.NameRegex = re
+	}
+
+	if v := *flagSweepTags; v != "" {
+		f.Tags = make(map[string]string)
+		for _, tag := range strings.Split(v, ",") {
+			key, value, _ := strings.Cut(tag, "=")
+			if key = strings.TrimSpace(key); key == "" {
+				return nil, fmt.Errorf("parsing -sweep-tags (%s): empty tag key", v)
+			}
+			f.Tags[key] = strings.TrimSpace(value)
+		}
+	}
+
+	if f.IsEmpty() {
+		return nil, nil
+	}
+
+	return f, nil
+}
+
+// IsEmpty returns whether the filter selects all resources.
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (f.MinAge == 0 && f.NamePrefix == "" && f.NameRegex == nil && len(f.Tags) == 0)
+}
+
+// Match returns whether the resource swept by the specified Sweepable is selected by the filter.
+// If the resource is not selected, the reason is returned.
+func (f *Filter) Match(ctx context.Context, sweepable Sweepable) (bool, string, error) {
+	if f.IsEmpty() {
+		return true, "", nil
+	}
+
+	inspectable, ok := sweepable.(Inspectable)
+	if !ok {
+		return false, "resource cannot be inspected", nil
+	}
+
+	name, tags, created, err := inspect(ctx, inspectable)
+	if err != nil {
+		return false, "", err
+	}
+
+	if f.NamePrefix != "" && !strings.HasPrefix(name, f.NamePrefix) {
+		return false, fmt.Sprintf("name (%s) does not have prefix (%s)", name, f.NamePrefix), nil
+	}
+
+	if f.NameRegex != nil && !f.NameRegex.MatchString(name) {
+		return false, fmt.Sprintf("name (%s) does not match (%s)", name, f.NameRegex), nil
+	}
+
+	for key, value := range f.Tags {
+		if v, ok := tags[key]; !ok {
+			return false, fmt.Sprintf("tag (%s) not found", key), nil
+		} else if value != "" && v != value {
+			return false, fmt.Sprintf("tag (%s) value (%s) does not match (%s)", key, v, value), nil
+		}
+	}
+
+	if f.MinAge > 0 && !created.IsZero() {
+		if age := time.Since(created); age < f.MinAge {
+			return false, fmt.Sprintf("age (%s) is less than (%s)", age.Round(time.Second), f.MinAge), nil
+		}
+	}
+
+	return true, "", nil
+}
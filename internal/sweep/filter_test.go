@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+func (testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func (s testSweepable) Inspect(_ context.Context, attributes []string) (map[string]string, map[string]string, error) {
+	values := make(map[string]string)
+	for _, attribute := range attributes {
+		if v, ok := s.attributes[attribute]; ok {
+			values[attribute] = v
+		}
+	}
+
+	return values, s.tags, nil
+}
+
+type testUninspectableSweepable struct{}
+
+func (testUninspectableSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	old := time.Now().Add(-48 * time.Hour).Format(time.RFC3339)
+	recent := time.Now().Add(-1 * time.Hour).Format(time.RFC3339)
+
+	testCases := []struct {
+		name      string
+		filter    *Filter
+		sweepable Sweepable
+		want      bool
+	}{
+		{
+			name:      "no filter",
+			sweepable: testUninspectableSweepable{},
+			want:      true,
+		},
+		{
+			name:      "not inspectable",
+			filter:    &Filter{NamePrefix: ResourcePrefix},
+			sweepable: testUninspectableSweepable{},
+		},
+		{
+			name:   "name prefix match",
+			filter: &Filter{NamePrefix: ResourcePrefix},
+			sweepable: testSweepable{
+				attributes: map[string]string{"id": "abc123", "name": "tf-acc-test-1234"},
+			},
+			want: true,
+		},
+		{
+			name:   "name prefix ID match",
+			filter: &Filter{NamePrefix: ResourcePrefix},
+			sweepable: testSweepable{
+				attributes: map[string]string{"id": "tf-acc-test-1234"},
+			},
+			want: true,
+		},
+		{
+			name:   "name prefix no match",
+			filter: &Filter{NamePrefix: ResourcePrefix},
+			sweepable: testSweepable{
+				attributes: map[string]string{"id": "abc123", "name": "production"},
+			},
+		},
+		{
+			name:   "name regex match",
+			filter: &Filter{NameRegex: regexp.MustCompile(`^tf-(acc|test)-`)},
+			sweepable: testSweepable{
+				attributes: map[string]string{"name": "tf-test-1234"},
+			},
+			want: true,
+		},
+		{
+			name:   "tag match",
+			filter: &Filter{Tags: map[string]string{"Owner": "sweeper", "Ephemeral": ""}},
+			sweepable: testSweepable{
+				tags: map[string]string{"Owner": "sweeper", "Ephemeral": "true"},
+			},
+			want: true,
+		},
+		{
+			name:   "tag value no match",
+			filter: &Filter{Tags: map[string]string{"Owner": "sweeper"}},
+			sweepable: testSweepable{
+				tags: map[string]string{"Owner": "team"},
+			},
+		},
+		{
+			name:      "tag not found",
+			filter:    &Filter{Tags: map[string]string{"Owner": ""}},
+			sweepable: testSweepable{},
+		},
+		{
+			name:   "old enough",
+			filter: &Filter{MinAge: 24 * time.Hour},
+			sweepable: testSweepable{
+				attributes: map[string]string{"created_at": old},
+			},
+			want: true,
+		},
+		{
+			name:   "too recent",
+			filter: &Filter{MinAge: 24 * time.Hour},
+			sweepable: testSweepable{
+				attributes: map[string]string{"creation_date": recent},
+			},
+		},
+		{
+			name:      "no creation time",
+			filter:    &Filter{MinAge: 24 * time.Hour},
+			sweepable: testSweepable{},
+			want:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason, err := testCase.filter.Match(ctx, testCase.sweepable)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t (%s), want %t", got, reason, testCase.want)
+			}
+		})
+	}
+}
@@ -5,7 +5,10 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,11 +18,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -38,6 +45,7 @@ type sweepResource struct {
 	factory    func(context.Context) (fwresource.ResourceWithConfigure, error)
 	meta       *conns.AWSClient
 	attributes []attribute
+	importID   string
 }
 
 func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), meta *conns.AWSClient, attributes ...attribute) *sweepResource {
@@ -55,25 +63,10 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 		return err
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
-
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
-
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	ctx, state, err := sr.configure(ctx, resource)
 
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
-	}
-
-	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	if err != nil {
+		return err
 	}
 
 	tflog.Info(ctx, "Sweeping resource")
@@ -118,6 +111,256 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// configure configures the resource and returns its state, with the sweeper's attributes set.
+func (sr *sweepResource) configure(ctx context.Context, resource fwresource.ResourceWithConfigure) (context.Context, tfsdk.State, error) {
+	metadata := resourceMetadata(ctx, resource)
+	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return ctx, state, fwdiag.DiagnosticsError(d)
+		}
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	return ctx, state, nil
+}
+
+// Inspect reads the resource and returns the values of any of the specified top-level string attributes that are set, and the resource's tags.
+func (sr *sweepResource) Inspect(ctx context.Context, attributes []string) (map[string]string, map[string]string, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, state, err := sr.configure(ctx, resource)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig, sr.meta.IgnoreTagsConfig)
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, nil, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil, &retry.NotFoundError{}
+	}
+
+	values := make(map[string]string)
+	for _, attribute := range attributes {
+		if _, ok := response.State.Schema.GetAttributes()[attribute]; !ok {
+			continue
+		}
+
+		// Attributes that are not strings are ignored.
+		var v *string
+		if d := response.State.GetAttribute(ctx, path.Root(attribute), &v); !d.HasError() && v != nil && *v != "" {
+			values[attribute] = *v
+		}
+	}
+
+	// Resources with transparent tagging return tags in Context.
+	if v, ok := tftags.FromContext(ctx); ok {
+		// Resources whose tags are only read by the transparent tagging interceptor don't set them during Read.
+		if v.TagsOut.IsNone() {
+			if err := sr.listTags(ctx, response.State); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if v.TagsOut.IsSome() {
+			return values, v.TagsOut.MustUnwrap().Map(), nil
+		}
+	}
+
+	for _, attribute := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := response.State.Schema.GetAttributes()[attribute]; !ok {
+			continue
+		}
+
+		var tags map[string]string
+		if d := response.State.GetAttribute(ctx, path.Root(attribute), &tags); !d.HasError() {
+			return values, tags, nil
+		}
+	}
+
+	return values, nil, nil
+}
+
+// listTags lists the tags of a resource that uses transparent tagging by calling its service package's ListTags method.
+// The tags are set in Context.
+func (sr *sweepResource) listTags(ctx context.Context, state tfsdk.State) error {
+	sp, spt, ok := sr.transparentTagging(ctx)
+	if !ok || spt.IdentifierAttribute == "" {
+		return nil
+	}
+
+	var identifier *string
+	if err := fwdiag.DiagnosticsError(state.GetAttribute(ctx, path.Root(spt.IdentifierAttribute), &identifier)); err != nil {
+		return err
+	}
+
+	if identifier == nil || *identifier == "" {
+		return nil
+	}
+
+	var err error
+	if v, ok := sp.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		err = v.ListTags(ctx, sr.meta, *identifier) // Sets tags in Context
+	} else if v, ok := sp.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && spt.ResourceType != "" {
+		err = v.ListTags(ctx, sr.meta, *identifier, spt.ResourceType) // Sets tags in Context
+	}
+
+	// ISO partitions may not support tagging, giving error.
+	if errs.IsUnsupportedOperationInPartitionError(sr.meta.Partition, err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing tags for %s: %w", *identifier, err)
+	}
+
+	return nil
+}
+
+// transparentTagging returns the service package and tagging configuration of the resource if it uses transparent tagging.
+// Sweepers don't know the resource's type name, so the resource is identified by its factory.
+func (sr *sweepResource) transparentTagging(ctx context.Context) (conns.ServicePackage, *types.ServicePackageResourceTags, bool) {
+	key := reflect.ValueOf(sr.factory).Pointer()
+
+	for _, sp := range sr.meta.ServicePackages {
+		for _, r := range sp.FrameworkResources(ctx) {
+			if r.Tags != nil && reflect.ValueOf(r.Factory).Pointer() == key {
+				return sp, r.Tags, true
+			}
+		}
+	}
+
+	return nil, nil, false
+}
+
+// Describe returns the resource's type name and an ID made up of the values of its identifying attributes.
+func (sr *sweepResource) Describe(ctx context.Context) (string, string) {
+	var typeName string
+	if resource, err := sr.factory(ctx); err == nil {
+		typeName = resourceMetadata(ctx, resource).TypeName
+	}
+
+	values := make([]string, len(sr.attributes))
+	for i, attr := range sr.attributes {
+		values[i] = fmt.Sprint(attr.value)
+	}
+
+	return typeName, strings.Join(values, ",")
+}
+
+// WithImportID sets the ID used to import the resource, for resources whose importer doesn't accept an ID derived from the sweeper's attributes.
+func (sr *sweepResource) WithImportID(id string) *sweepResource {
+	sr.importID = id
+	return sr
+}
+
+// ImportID returns the ID used to import the resource, if the resource can be imported.
+// Unless set using WithImportID, this is the value of the resource's ID attribute, or the values of the sweeper's attributes
+// separated by commas, if the resource's importer accepts it and sets the same attribute values.
+func (sr *sweepResource) ImportID(ctx context.Context) (string, bool) {
+	if sr.importID != "" {
+		return sr.importID, true
+	}
+
+	resource, err := sr.factory(ctx)
+	if err != nil {
+		return "", false
+	}
+
+	importer, ok := resource.(fwresource.ResourceWithImportState)
+	if !ok {
+		return "", false
+	}
+
+	ctx, state, err := sr.configure(ctx, resource)
+	if err != nil {
+		return "", false
+	}
+
+	var ids []string
+	for _, attr := range sr.attributes {
+		if attr.path == names.AttrID {
+			ids = append(ids, fmt.Sprint(attr.value))
+		}
+	}
+	if _, id := sr.Describe(ctx); id != "" && !slices.Contains(ids, id) {
+		ids = append(ids, id)
+	}
+
+	for _, id := range ids {
+		response := fwresource.ImportStateResponse{
+			State: tfsdk.State{
+				Raw:    tftypes.NewValue(state.Schema.Type().TerraformType(ctx), nil),
+				Schema: state.Schema,
+			},
+		}
+		importer.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, &response)
+
+		if !response.Diagnostics.HasError() && sr.imported(state, response.State) {
+			return id, true
+		}
+	}
+
+	return "", false
+}
+
+// imported returns whether an importer set any of the sweeper's attributes, and set them all to the sweeper's values.
+func (sr *sweepResource) imported(state, imported tfsdk.State) bool {
+	var n int
+
+	for _, attr := range sr.attributes {
+		p := tftypes.NewAttributePath().WithAttributeName(attr.path)
+
+		got, _, err := tftypes.WalkAttributePath(imported.Raw, p)
+		if err != nil {
+			continue
+		}
+		if v, ok := got.(tftypes.Value); !ok || v.IsNull() {
+			continue
+		}
+
+		want, _, err := tftypes.WalkAttributePath(state.Raw, p)
+		if err != nil {
+			return false
+		}
+		if !got.(tftypes.Value).Equal(want.(tftypes.Value)) {
+			return false
+		}
+
+		n++
+	}
+
+	return n > 0
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+// errDirectModification is returned for AWS API calls that a sweeper makes to modify resources other than via SweepOrchestrator
+// when such calls are not allowed.
+var errDirectModification = errors.New("sweeper modifies resources directly, not via SweepOrchestrator, which is not supported in dry-run mode or when resources are filtered")
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+func isReadOnlyOperation(operation string) bool {
+	for _, v := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// guardAPICall prevents sweepers from modifying resources other than via SweepOrchestrator in dry-run mode or when resources are filtered.
+// Sweepers that only delete resources via SweepOrchestrator list their resources as normal.
+func guardAPICall(ctx context.Context, service, operation string) error {
+	if (!dryRun && filter.IsEmpty()) || isReadOnlyOperation(operation) {
+		return nil
+	}
+
+	// SweepOrchestrator only deletes resources that are selected by the filter.
+	if info, ok := fromContext(ctx); ok && info.deleting {
+		return nil
+	}
+
+	log.Printf("[WARN] Preventing %s %s call", service, operation)
+	preventedCalls.record(ctx)
+
+	return fmt.Errorf("%s %s: %w", service, operation, errDirectModification)
+}
+
+// preventedCallCounter counts the AWS API calls prevented by guardAPICall, so that a sweeper that made any
+// is detected however it handles the returned error.
+type preventedCallCounter struct {
+	lock      sync.Mutex
+	total     int
+	bySweeper map[string]int // Keyed by Region and name, for sweepers registered using Register.
+}
+
+var preventedCalls = &preventedCallCounter{
+	bySweeper: make(map[string]int),
+}
+
+// record records a prevented call made using the specified Context.
+func (c *preventedCallCounter) record(ctx context.Context) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.total++
+	if info, ok := fromContext(ctx); ok && info.sweeper != "" {
+		c.bySweeper[info.region+"/"+info.sweeper]++
+	}
+}
+
+// count returns the number of prevented calls made by the named sweeper in the specified Region,
+// or by any sweeper if name is empty.
+func (c *preventedCallCounter) count(region, name string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if name == "" {
+		return c.total
+	}
+
+	return c.bySweeper[region+"/"+name]
+}
+
+// guardLock is held exclusively by sweepers registered using AddTestSweepers while AWS API calls are guarded.
+// Such sweepers create their own Context, so any call they make can only be attributed to them while no other sweeper runs.
+var guardLock sync.RWMutex
+
+// runGuarded runs the specified sweeper in a Region and returns whether it made any AWS API calls that were prevented.
+func runGuarded(s *sweeper, region string) (bool, error) {
+	// Sweepers registered using Register set their name in Context.
+	var name string
+	if s.list != nil {
+		name = s.name
+		guardLock.RLock()
+		defer guardLock.RUnlock()
+	} else {
+		guardLock.Lock()
+		defer guardLock.Unlock()
+	}
+
+	n := preventedCalls.count(region, name)
+	err := s.f(region)
+
+	return preventedCalls.count(region, name) > n, err
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"DescribeInstances":   true,
+		"GetBucketPolicy":     true,
+		"ListTagsForResource": true,
+		"BatchGetItem":        true,
+		"DeleteBucket":        false,
+		"TerminateInstances":  false,
+		"PutBucketPolicy":     false,
+		"DisassociateRoute":   false,
+	}
+
+	for operation, want := range testCases {
+		if got := isReadOnlyOperation(operation); got != want {
+			t.Errorf("isReadOnlyOperation(%q) = %t, want %t", operation, got, want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Importable is implemented by Sweepables that can identify the resource that they sweep for import into Terraform state.
+type Importable interface {
+	// ImportID returns the ID used to import the swept resource, or false if the resource cannot be imported.
+	ImportID(ctx context.Context) (string, bool)
+}
+
+// Listable returns the names of registered sweepers whose resources can be listed using List, sorted by name.
+// Only sweepers registered using Register can list resources without deleting them.
+func Listable() []string {
+	return sweepers.listable()
+}
+
+// List returns the resources that the named sweeper would sweep in the specified AWS Region.
+// No resources are deleted.
+// If the resources cannot be listed in the Region, for example because the service is not available, no resources are returned.
+func List(region, name string) ([]Sweepable, error) {
+	s, ok := sweepers.get(name)
+	if !ok {
+		return nil, fmt.Errorf("sweeper (%s) not found", name)
+	}
+
+	if s.list == nil {
+		return nil, fmt.Errorf("sweeper (%s) cannot list resources", name)
+	}
+
+	ctx := Context(region)
+	ctx = withSweeper(ctx, name)
+	ctx = logWithResourceType(ctx, name)
+
+	client, err := SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("getting client: %w", err)
+	}
+
+	sweepables, err := s.list(ctx, client)
+
+	if SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping listing", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing %q (%s): %w", name, region, err)
+	}
+
+	return sweepables, nil
+}
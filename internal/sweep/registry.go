@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// sweeper is a registered resource sweeper.
+type sweeper struct {
+	name         string
+	f            resource.SweeperFunc
+	dependencies []string  // Sweepers that must be run before this sweeper.
+	list         SweeperFn // Lists the resources to sweep. Set for sweepers registered using Register.
+}
+
+// registry contains resource sweepers and the dependencies between them.
+type registry struct {
+	graph    *depgraph.Graph
+	lock     sync.Mutex
+	sweepers map[string]*sweeper
+}
+
+func newRegistry() *registry {
+	return &registry{
+		graph:    depgraph.New(),
+		sweepers: make(map[string]*sweeper),
+	}
+}
+
+// sweepers contains all registered resource sweepers.
+var sweepers = newRegistry()
+
+// AddTestSweepers registers a resource sweeper that is passed the AWS Region to sweep.
+// In dry-run mode or when resources are filtered, AWS API calls that would modify resources other than via SweepOrchestrator fail and the sweeper is skipped.
+// Prefer Register for new sweepers.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if err := sweepers.add(&sweeper{
+		name:         name,
+		f:            s.F,
+		dependencies: s.Dependencies,
+	}); err != nil {
+		log.Fatalf("[ERR] %s", err)
+	}
+}
+
+// add registers a sweeper.
+// Returns an error if a sweeper with the same name is already registered or if its dependencies introduce a cycle.
+func (r *registry) add(s *sweeper) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.sweepers[s.name]; ok {
+		return fmt.Errorf("adding sweeper (%s): already registered", s.name)
+	}
+
+	// Dependencies may not be registered yet.
+	r.graph.AddNode(s.name)
+	for _, dependency := range s.dependencies {
+		r.graph.AddNode(dependency)
+		if err := r.graph.AddDependency(s.name, dependency); err != nil {
+			return fmt.Errorf("adding sweeper (%s): %w", s.name, err)
+		}
+	}
+
+	// The graph was acyclic before this sweeper's dependencies were added, so any cycle includes this sweeper.
+	if _, err := r.graph.DependenciesOf(s.name); err != nil {
+		for _, dependency := range s.dependencies {
+			r.graph.RemoveDependency(s.name, dependency)
+		}
+
+		return fmt.Errorf("adding sweeper (%s): %w", s.name, err)
+	}
+
+	r.sweepers[s.name] = s
+
+	return nil
+}
+
+// get returns the named sweeper, if registered.
+func (r *registry) get(name string) (*sweeper, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	s, ok := r.sweepers[name]
+
+	return s, ok
+}
+
+// listable returns the names of the sweepers that can list the resources that they sweep, sorted by name.
+func (r *registry) listable() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var names []string
+	for name, s := range r.sweepers {
+		if s.list != nil {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// filter returns the names of the sweepers to run, in dependency order.
+// filter is a comma-separated list of case-insensitive substrings of sweeper names.
+// Any dependencies of matching sweepers are also returned. An empty filter returns all sweepers.
+func (r *registry) filter(filter string) ([]string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	order, err := r.graph.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	var substrings []string
+	for _, v := range strings.Split(strings.ToLower(filter), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			substrings = append(substrings, v)
+		}
+	}
+
+	selected := make(map[string]struct{})
+	for _, name := range order {
+		if _, ok := r.sweepers[name]; !ok {
+			continue
+		}
+
+		if len(substrings) > 0 && !slices.ContainsFunc(substrings, func(v string) bool {
+			return strings.Contains(strings.ToLower(name), v)
+		}) {
+			continue
+		}
+
+		dependencies, err := r.graph.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		selected[name] = struct{}{}
+		for _, dependency := range dependencies {
+			selected[dependency] = struct{}{}
+		}
+	}
+
+	var names []string
+	for _, name := range order {
+		if _, ok := selected[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestRegistryAdd(t *testing.T) {
+	t.Parallel()
+
+	r := newRegistry()
+
+	for _, s := range []*sweeper{
+		{name: "aws_a", dependencies: []string{"aws_b"}},
+		{name: "aws_b", dependencies: []string{"aws_c"}},
+	} {
+		if err := r.add(s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := r.add(&sweeper{name: "aws_a"}); err == nil {
+		t.Error("expected duplicate sweeper error, got none")
+	}
+
+	if err := r.add(&sweeper{name: "aws_c", dependencies: []string{"aws_a"}}); err == nil {
+		t.Error("expected dependency cycle error, got none")
+	}
+
+	// The rejected sweeper's dependencies are not kept.
+	if err := r.add(&sweeper{name: "aws_c"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestRegistryListable(t *testing.T) {
+	t.Parallel()
+
+	r := newRegistry()
+
+	for _, s := range []*sweeper{
+		{name: "aws_c", list: func(context.Context, *conns.AWSClient) ([]Sweepable, error) { return nil, nil }},
+		{name: "aws_b"},
+		{name: "aws_a", list: func(context.Context, *conns.AWSClient) ([]Sweepable, error) { return nil, nil }},
+	} {
+		if err := r.add(s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if diff := cmp.Diff(r.listable(), []string{"aws_a", "aws_c"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRegistryFilter(t *testing.T) {
+	t.Parallel()
+
+	r := newRegistry()
+
+	for _, s := range []*sweeper{
+		{name: "aws_vpc", dependencies: []string{"aws_subnet", "aws_internet_gateway"}},
+		{name: "aws_subnet", dependencies: []string{"aws_instance"}},
+		{name: "aws_instance"},
+		{name: "aws_internet_gateway"},
+		{name: "aws_iam_role", dependencies: []string{"aws_instance"}},
+	} {
+		if err := r.add(s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		filter string
+		want   []string
+	}{
+		{
+			name: "all",
+			want: []string{"aws_instance", "aws_subnet", "aws_internet_gateway", "aws_vpc", "aws_iam_role"},
+		},
+		{
+			name:   "with dependencies",
+			filter: "SUBNET",
+			want:   []string{"aws_instance", "aws_subnet"},
+		},
+		{
+			name:   "multiple",
+			filter: "aws_iam,gateway",
+			want:   []string{"aws_instance", "aws_internet_gateway", "aws_iam_role"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := r.filter(testCase.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRegistryRunRegion(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocked bool // Are AWS API calls that modify resources prevented?
+		lock    sync.Mutex
+		ran     []string
+	)
+	f := func(name string, err error) func(string) error {
+		return func(string) error {
+			lock.Lock()
+			defer lock.Unlock()
+
+			ran = append(ran, name)
+
+			if name == "aws_b" && blocked {
+				// The guard's error isn't wrapped, so only the prevented call identifies the sweeper as skipped.
+				preventedCalls.record(Context("us-west-2")) //lintignore:AWSAT003
+				return fmt.Errorf("deleting: %s", errDirectModification)
+			}
+
+			return err
+		}
+	}
+
+	r := newRegistry()
+
+	for _, s := range []*sweeper{
+		{name: "aws_a", f: f("aws_a", nil), dependencies: []string{"aws_b"}},
+		{name: "aws_b", f: f("aws_b", nil), dependencies: []string{"aws_c"}},
+		{name: "aws_c", f: f("aws_c", nil)},
+		{name: "aws_d", f: f("aws_d", errors.New("failed"))},
+		{name: "aws_e", f: f("aws_e", nil), dependencies: []string{"aws_d"}},
+	} {
+		if err := r.add(s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	names, err := r.filter("aws_a")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ran = nil
+	if err := r.runRegion("us-west-2", names, runOptions{parallelism: 2}); err != nil { //lintignore:AWSAT003
+		t.Errorf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(ran, []string{"aws_c", "aws_b", "aws_a"}); diff != "" {
+		t.Errorf("unexpected order diff (+wanted, -got): %s", diff)
+	}
+
+	// In dry-run mode a sweeper that modifies resources directly is skipped, as are its dependents, without failing the run.
+	ran, blocked = nil, true
+	err = r.runRegion("us-west-2", names, runOptions{dryRun: true}) //lintignore:AWSAT003
+	blocked = false
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(ran, []string{"aws_c", "aws_b"}); diff != "" {
+		t.Errorf("unexpected dry run diff (+wanted, -got): %s", diff)
+	}
+
+	names, err = r.filter("aws_e")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ran = nil
+	if err := r.runRegion("us-west-2", names, runOptions{}); err == nil { //lintignore:AWSAT003
+		t.Error("expected error, got none")
+	}
+	if slices.Contains(ran, "aws_e") {
+		t.Error("expected sweeper with failed dependency to be skipped")
+	}
+
+	ran = nil
+	if err := r.runRegion("us-west-2", names, runOptions{allowFailures: true}); err == nil { //lintignore:AWSAT003
+		t.Error("expected error, got none")
+	}
+	if !slices.Contains(ran, "aws_e") {
+		t.Error("expected sweeper with failed dependency to be run")
+	}
+}
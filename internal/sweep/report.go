@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Swept resource outcomes.
+const (
+	ResourceOutcomeDeleted     = "deleted"
+	ResourceOutcomeFailed      = "failed"
+	ResourceOutcomeFiltered    = "filtered"
+	ResourceOutcomeWouldDelete = "would_delete"
+)
+
+// Sweeper outcomes.
+const (
+	SweeperOutcomeFailed    = "failed"
+	SweeperOutcomeSkipped   = "skipped"
+	SweeperOutcomeSucceeded = "succeeded"
+)
+
+// Describer is implemented by Sweepables that can identify the resource that they sweep.
+type Describer interface {
+	// Describe returns the swept resource's Terraform type name, if known, and ID.
+	Describe(ctx context.Context) (string, string)
+}
+
+type reportResource struct {
+	Region       string `json:"region,omitempty"`
+	Sweeper      string `json:"sweeper,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ID           string `json:"id,omitempty"`
+	Outcome      string `json:"outcome"`
+	Error        string `json:"error,omitempty"` // For filtered resources, the reason that the resource was not selected.
+}
+
+type reportSweeper struct {
+	Region   string `json:"region"`
+	Name     string `json:"name"`
+	Outcome  string `json:"outcome"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration,omitempty"`
+}
+
+// report is a machine-readable record of a sweeper run.
+type report struct {
+	DryRun    bool             `json:"dry_run"`
+	Resources []reportResource `json:"resources"`
+	Sweepers  []reportSweeper  `json:"sweepers"`
+
+	lock sync.Mutex
+}
+
+func newReport(dryRun bool) *report {
+	return &report{
+		DryRun:    dryRun,
+		Resources: make([]reportResource, 0),
+		Sweepers:  make([]reportSweeper, 0),
+	}
+}
+
+// describe returns the swept resource's Terraform type name and ID, where known.
+// Sweepers registered using Register are named after the type of resource that they sweep.
+func describe(ctx context.Context, sweepable Sweepable) (string, string) {
+	var typeName, id string
+
+	if info, ok := fromContext(ctx); ok {
+		typeName = info.sweeper
+	}
+
+	if d, ok := sweepable.(Describer); ok {
+		var v string
+		if v, id = d.Describe(ctx); v != "" {
+			typeName = v
+		}
+	}
+
+	return typeName, id
+}
+
+// addResource records the outcome of sweeping a single resource.
+func (r *report) addResource(ctx context.Context, sweepable Sweepable, outcome string, err error) {
+	if r == nil {
+		return
+	}
+
+	v := reportResource{
+		Outcome: outcome,
+	}
+
+	if info, ok := fromContext(ctx); ok {
+		v.Region = info.region
+		v.Sweeper = info.sweeper
+	}
+
+	v.ResourceType, v.ID = describe(ctx, sweepable)
+
+	if err != nil {
+		v.Error = err.Error()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Resources = append(r.Resources, v)
+}
+
+// addSweeper records the outcome of running a sweeper in a Region.
+func (r *report) addSweeper(region, name, outcome string, duration time.Duration, err error) {
+	if r == nil {
+		return
+	}
+
+	v := reportSweeper{
+		Region:  region,
+		Name:    name,
+		Outcome: outcome,
+	}
+
+	if duration > 0 {
+		v.Duration = duration.String()
+	}
+
+	if err != nil {
+		v.Error = err.Error()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Sweepers = append(r.Sweepers, v)
+}
+
+// write writes the report as JSON to the specified file.
+func (r *report) write(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding sweeper report: %w", err)
+	}
+
+	if err := os.WriteFile(path, b, 0644); err != nil { //nolint:gosec // Sweeper reports are not sensitive.
+		return fmt.Errorf("writing sweeper report (%s): %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const defaultSweeperParallelism = 10
+
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the resources that sweepers would delete, without deleting them")
+	flagSweepParallelism = flag.Int("sweep-parallelism", defaultSweeperParallelism, "Maximum number of sweepers run in parallel in each Region")
+	flagSweepReport      = flag.String("sweep-report", "", "Path of a JSON file that a report of swept resources is written to")
+)
+
+var (
+	// dryRun and runReport, like filter, are set for the duration of a sweeper run.
+	dryRun    bool
+	runReport *report
+)
+
+// runOptions contains options for a sweeper run.
+type runOptions struct {
+	allowFailures bool
+	dryRun        bool
+	filtered      bool
+	parallelism   int
+}
+
+// TestMain adds sweeper functionality to the "go test" command, otherwise tests are executed as normal.
+//
+// Sweepers are run in dependency order. Regions are swept in parallel and, within a Region,
+// sweepers whose dependencies have completed are run in parallel.
+//
+// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by the Terraform Plugin Testing
+// helper/resource package. Additional sweeper flags added to the "go test" command:
+//
+//	-sweep-dry-run: List the resources that sweepers would delete, without deleting them.
+//	-sweep-min-age: Only sweep resources created at least this long ago, where a creation time exists.
+//	-sweep-name-prefix: Only sweep resources whose name starts with this prefix.
+//	-sweep-name-regex: Only sweep resources whose name matches this regular expression.
+//	-sweep-parallelism: Maximum number of sweepers run in parallel in each Region. Defaults to 10.
+//	-sweep-report: Path of a JSON file that a report of swept resources is written to.
+//	-sweep-tags: Comma-separated list of tags, as key or key=value, that swept resources must have.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	var err error
+	filter, err = newFilterFromFlags()
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	opts := runOptions{
+		allowFailures: flagValue("sweep-allow-failures") == "true",
+		dryRun:        *flagSweepDryRun,
+		filtered:      !filter.IsEmpty(),
+		parallelism:   *flagSweepParallelism,
+	}
+
+	dryRun = opts.dryRun
+	runReport = newReport(opts.dryRun)
+
+	err = sweepers.run(strings.Split(regions, ","), flagValue("sweep-run"), opts)
+
+	if path := *flagSweepReport; path != "" {
+		if err := runReport.write(path); err != nil {
+			log.Printf("[ERROR] %s", err)
+		}
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// run runs the sweepers selected by sweepRun in each of the specified Regions.
+func (r *registry) run(regions []string, sweepRun string, opts runOptions) error {
+	names, err := r.filter(sweepRun)
+	if err != nil {
+		return err
+	}
+
+	var g multierror.Group
+
+	for _, region := range regions {
+		region := strings.TrimSpace(region)
+
+		g.Go(func() error {
+			return r.runRegion(region, names, opts)
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// runRegion runs the specified sweepers, which must be in dependency order, in a Region.
+// Each sweeper is run once all of its dependencies have completed.
+func (r *registry) runRegion(region string, names []string, opts runOptions) error {
+	start := time.Now()
+	log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+	done := make(map[string]chan struct{}, len(names))
+	for _, name := range names {
+		done[name] = make(chan struct{})
+	}
+
+	var (
+		lock     sync.Mutex
+		failures = make(map[string]error) // Sweepers that failed or were skipped because of a failure.
+		skipped  = make(map[string]bool)  // Sweepers that were skipped because they modify resources directly.
+		wg       sync.WaitGroup
+	)
+	semaphore := make(chan struct{}, max(opts.parallelism, 1))
+
+	for _, name := range names {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer close(done[name])
+
+			s, ok := r.get(name)
+			if !ok {
+				err := fmt.Errorf("sweeper (%s) not found", name)
+				log.Printf("[ERROR] %s", err)
+				runReport.addSweeper(region, name, SweeperOutcomeFailed, 0, err)
+
+				lock.Lock()
+				failures[name] = err
+				lock.Unlock()
+
+				return
+			}
+
+			for _, dependency := range s.dependencies {
+				<-done[dependency]
+			}
+
+			outcome, duration, err := func() (string, time.Duration, error) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				// Resources that a skipped sweeper would delete may prevent the deletion of this sweeper's resources.
+				for _, dependency := range s.dependencies {
+					if skipped[dependency] {
+						skipped[name] = true
+						return SweeperOutcomeSkipped, 0, fmt.Errorf("dependency (%s) was skipped", dependency)
+					}
+				}
+
+				if !opts.allowFailures {
+					for _, dependency := range s.dependencies {
+						if _, ok := failures[dependency]; ok {
+							err := fmt.Errorf("dependency (%s) was not swept", dependency)
+							failures[name] = err
+							return SweeperOutcomeSkipped, 0, err
+						}
+					}
+
+					if len(failures) > 0 {
+						err := errors.New("another sweeper failed")
+						failures[name] = err
+						return SweeperOutcomeSkipped, 0, err
+					}
+				}
+
+				return "", 0, nil
+			}()
+
+			if outcome == "" {
+				semaphore <- struct{}{}
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+				start := time.Now()
+				var prevented bool
+				if opts.dryRun || opts.filtered {
+					prevented, err = runGuarded(s, region)
+				} else {
+					err = s.f(region)
+				}
+				duration = time.Since(start)
+				<-semaphore
+
+				if prevented {
+					// The sweeper's resources can't be listed or filtered without deleting them.
+					outcome = SweeperOutcomeSkipped
+					err = errDirectModification
+					log.Printf("[WARN] Skipped Sweeper (%s) in region (%s): %s", name, region, err)
+
+					lock.Lock()
+					skipped[name] = true
+					lock.Unlock()
+				} else if err != nil {
+					outcome = SweeperOutcomeFailed
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+
+					lock.Lock()
+					failures[name] = err
+					lock.Unlock()
+				} else {
+					outcome = SweeperOutcomeSucceeded
+				}
+			} else {
+				log.Printf("[DEBUG] Skipping Sweeper (%s) in region (%s): %s", name, region, err)
+			}
+
+			runReport.addSweeper(region, name, outcome, duration, err)
+		}()
+	}
+
+	wg.Wait()
+
+	log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+	if len(failures) > 0 {
+		log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:", region)
+		for _, name := range names {
+			if err, ok := failures[name]; ok {
+				log.Printf("\t- %s: %s", name, err)
+			}
+		}
+
+		return fmt.Errorf("sweeping region (%s): %d sweepers failed or were skipped", region, len(failures))
+	}
+
+	return nil
+}
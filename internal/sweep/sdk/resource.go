@@ -5,23 +5,33 @@ package sdk
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
 	d        *schema.ResourceData
+	importID string
 	meta     *conns.AWSClient
 	resource *schema.Resource
 }
@@ -89,6 +99,93 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the resource's ID. The resource's type name is not known.
+func (sr *sweepResource) Describe(context.Context) (string, string) {
+	return "", sr.d.Id()
+}
+
+// WithImportID sets the ID used to import the resource, for resources whose importer doesn't accept the resource's ID.
+func (sr *sweepResource) WithImportID(id string) *sweepResource {
+	sr.importID = id
+	return sr
+}
+
+// ImportID returns the ID used to import the resource, if the resource can be imported.
+// Unless set using WithImportID, this is the resource's ID if the resource's importer accepts it.
+func (sr *sweepResource) ImportID(ctx context.Context) (string, bool) {
+	if sr.importID != "" {
+		return sr.importID, true
+	}
+
+	if sr.resource.Importer == nil || sr.d.Id() == "" {
+		return "", false
+	}
+
+	d := sr.resource.Data(nil)
+	d.SetId(sr.d.Id())
+
+	if err := importResource(ctx, sr.resource, d, sr.meta); err != nil {
+		tflog.Debug(ctx, "Resource ID is not an import ID", map[string]any{
+			"id":  sr.d.Id(),
+			"err": err.Error(),
+		})
+		return "", false
+	}
+
+	return sr.d.Id(), true
+}
+
+// Inspect reads the resource and returns the values of any of the specified top-level string attributes that are set, and the resource's tags.
+func (sr *sweepResource) Inspect(ctx context.Context, attributes []string) (map[string]string, map[string]string, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig, sr.meta.IgnoreTagsConfig)
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return nil, nil, err
+	}
+
+	if sr.d.Id() == "" {
+		return nil, nil, &retry.NotFoundError{}
+	}
+
+	// Use SchemaMap so that resources defined using SchemaFunc are handled.
+	schemaMap := sr.resource.SchemaMap()
+	values := map[string]string{
+		names.AttrID: sr.d.Id(),
+	}
+	for _, attribute := range attributes {
+		if v, ok := schemaMap[attribute]; !ok || v.Type != schema.TypeString {
+			continue
+		}
+
+		if v, ok := sr.d.GetOk(attribute); ok {
+			values[attribute] = v.(string)
+		}
+	}
+
+	// Resources with transparent tagging return tags in Context.
+	if v, ok := tftags.FromContext(ctx); ok {
+		// Resources whose tags are only read by the transparent tagging interceptor don't set them during Read.
+		if v.TagsOut.IsNone() {
+			if err := listTags(ctx, sr.resource, sr.d, sr.meta); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if v.TagsOut.IsSome() {
+			return values, v.TagsOut.MustUnwrap().Map(), nil
+		}
+	}
+
+	for _, attribute := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := schemaMap[attribute]; ok {
+			return values, flex.ExpandStringValueMap(sr.d.Get(attribute).(map[string]interface{})), nil
+		}
+	}
+
+	return values, nil, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -121,6 +218,29 @@ func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.Re
 	return resource.Delete(d, meta)
 }
 
+// importResource runs the resource's importer, returning an error if the importer doesn't accept the resource's ID.
+func importResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
+	// Importers without a function accept any ID.
+	data := []*schema.ResourceData{d}
+	var err error
+
+	if resource.Importer.StateContext != nil {
+		data, err = resource.Importer.StateContext(ctx, d, meta)
+	} else if resource.Importer.State != nil {
+		data, err = resource.Importer.State(d, meta)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 || data[0].Id() == "" {
+		return errors.New("no resource imported")
+	}
+
+	return nil
+}
+
 func ReadResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
 	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
 		var diags diag.Diagnostics
@@ -136,3 +256,100 @@ func ReadResource(ctx context.Context, resource *schema.Resource, d *schema.Reso
 
 	return resource.Read(d, meta)
 }
+
+// listTags lists the tags of a resource that uses transparent tagging by calling its service package's ListTags method.
+// The tags are set in Context.
+func listTags(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
+	sp, spt, ok := transparentTagging(ctx, resource, meta)
+	if !ok {
+		return nil
+	}
+
+	var identifier string
+	if identifierAttribute := spt.IdentifierAttribute; identifierAttribute == names.AttrID {
+		identifier = d.Id()
+	} else {
+		identifier, _ = d.Get(identifierAttribute).(string)
+	}
+
+	if identifier == "" {
+		return nil
+	}
+
+	var err error
+	if v, ok := sp.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
+	} else if v, ok := sp.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && spt.ResourceType != "" {
+		err = v.ListTags(ctx, meta, identifier, spt.ResourceType) // Sets tags in Context
+	}
+
+	// ISO partitions may not support tagging, giving error.
+	if errs.IsUnsupportedOperationInPartitionError(meta.Partition, err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing tags for %s: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// taggedResources caches, per service package, the tagging configuration of resources that use transparent tagging, keyed by read function.
+var taggedResources sync.Map // map[conns.ServicePackage]map[uintptr]*types.ServicePackageResourceTags
+
+// transparentTagging returns the service package and tagging configuration of the specified resource if it uses transparent tagging.
+// Sweepers don't know the resource's type name, so the resource is identified by its read function.
+func transparentTagging(ctx context.Context, resource *schema.Resource, meta *conns.AWSClient) (conns.ServicePackage, *types.ServicePackageResourceTags, bool) {
+	key := readFunc(resource)
+	if key == 0 {
+		return nil, nil, false
+	}
+
+	for _, sp := range meta.ServicePackages {
+		v, ok := taggedResources.Load(sp)
+		if !ok {
+			resources := make(map[uintptr]*types.ServicePackageResourceTags)
+			for _, r := range sp.SDKResources(ctx) {
+				if r.Tags == nil {
+					continue
+				}
+				k := readFunc(r.Factory())
+				if k == 0 {
+					continue
+				}
+				// Resources sharing a read function can't be told apart.
+				if _, ok := resources[k]; ok {
+					resources[k] = nil
+				} else {
+					resources[k] = r.Tags
+				}
+			}
+			v, _ = taggedResources.LoadOrStore(sp, resources)
+		}
+
+		if spt := v.(map[uintptr]*types.ServicePackageResourceTags)[key]; spt != nil {
+			return sp, spt, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+// readFunc returns the address of the resource's read function, or 0 if it has none.
+func readFunc(resource *schema.Resource) uintptr {
+	switch {
+	case resource.ReadContext != nil:
+		return reflect.ValueOf(resource.ReadContext).Pointer()
+	case resource.ReadWithoutTimeout != nil:
+		return reflect.ValueOf(resource.ReadWithoutTimeout).Pointer()
+	case resource.Read != nil:
+		return reflect.ValueOf(resource.Read).Pointer()
+	default:
+		return 0
+	}
+}
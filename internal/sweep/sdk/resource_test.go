@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSweepResourceInspect_schemaFunc(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &schema.Resource{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrTags: {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrTagsAll: {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			}
+		},
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.Set(names.AttrName, "tf-acc-test-example")
+			d.Set(names.AttrTagsAll, map[string]string{"Owner": "test"})
+
+			return nil
+		},
+	}
+
+	d := r.Data(nil)
+	d.SetId("example-id")
+
+	values, tags, err := NewSweepResource(r, d, &conns.AWSClient{}).Inspect(ctx, []string{names.AttrName, "created_at"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(values, map[string]string{names.AttrID: "example-id", names.AttrName: "tf-acc-test-example"}); diff != "" {
+		t.Errorf("unexpected values diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(tags, map[string]string{"Owner": "test"}); diff != "" {
+		t.Errorf("unexpected tags diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepResourceInspect_transparentTagging(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sp := &testServicePackage{
+		tags: map[string]string{"Owner": "test"},
+	}
+	meta := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			"test": sp,
+		},
+	}
+
+	r := testTaggedResource()
+	d := r.Data(nil)
+	d.SetId("example-id")
+
+	_, tags, err := NewSweepResource(r, d, meta).Inspect(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(sp.identifiers, []string{"arn:aws:test:us-west-2:123456789012:example/example-id"}); diff != "" { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("unexpected ListTags identifiers diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(tags, map[string]string{"Owner": "test"}); diff != "" {
+		t.Errorf("unexpected tags diff (+wanted, -got): %s", diff)
+	}
+}
+
+// testTaggedResource returns a resource that uses transparent tagging and doesn't set its tags during Read.
+func testTaggedResource() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: testTaggedResourceRead,
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTagsAll: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func testTaggedResourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.Set(names.AttrARN, "arn:aws:test:us-west-2:123456789012:example/"+d.Id()) //lintignore:AWSAT003,AWSAT005
+
+	return nil
+}
+
+type testServicePackage struct {
+	identifiers []string
+	tags        map[string]string
+}
+
+func (*testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (*testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (*testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (*testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  testTaggedResource,
+			TypeName: "aws_test_tagged",
+			Name:     "Tagged",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
+}
+
+func (*testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (sp *testServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	sp.identifiers = append(sp.identifiers, identifier)
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, sp.tags))
+	}
+
+	return nil
+}
+
+func TestSweepResourceImportID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		importer *schema.ResourceImporter
+		importID string
+		wantID   string
+		wantOK   bool
+	}{
+		"not importable": {},
+		"passthrough": {
+			importer: &schema.ResourceImporter{
+				StateContext: schema.ImportStatePassthroughContext,
+			},
+			wantID: "example-id",
+			wantOK: true,
+		},
+		"ID not accepted": {
+			importer: &schema.ResourceImporter{
+				StateContext: func(context.Context, *schema.ResourceData, any) ([]*schema.ResourceData, error) {
+					return nil, errors.New("unexpected format for ID")
+				},
+			},
+		},
+		"import ID set": {
+			importer: &schema.ResourceImporter{
+				StateContext: func(context.Context, *schema.ResourceData, any) ([]*schema.ResourceData, error) {
+					return nil, errors.New("unexpected format for ID")
+				},
+			},
+			importID: "example-parent,example-id",
+			wantID:   "example-parent,example-id",
+			wantOK:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Importer: testCase.importer,
+			}
+			d := r.Data(nil)
+			d.SetId("example-id")
+
+			sr := NewSweepResource(r, d, &conns.AWSClient{})
+			if testCase.importID != "" {
+				sr = sr.WithImportID(testCase.importID)
+			}
+
+			id, ok := sr.ImportID(ctx)
+
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := id, testCase.wantID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
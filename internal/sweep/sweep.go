@@ -5,14 +5,18 @@ package sweep
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"net/http"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
@@ -31,12 +35,23 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
+// HTTPClient, if set, is the http.Client used for AWS API calls made by sweepers.
+// It allows sweepers to be run against recorded responses.
+var HTTPClient *http.Client
+
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+// Sweepers are run in parallel, so access is guarded by sweeperClientsLock.
+var (
+	sweeperClients     map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsLock sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -54,6 +69,9 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	meta := new(conns.AWSClient)
+	if HTTPClient != nil {
+		meta.SetHTTPClient(ctx, HTTPClient)
+	}
 	servicePackageMap := make(map[string]conns.ServicePackage)
 	for _, sp := range ServicePackages {
 		servicePackageName := sp.ServicePackageName()
@@ -62,30 +80,35 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	meta.ServicePackages = servicePackageMap
 
 	conf := &conns.Config{
+		APICallGuard:     guardAPICall,
 		MaxRetries:       5,
 		Region:           region,
 		SuppressDebugLog: true,
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = append(conf.AssumeRole, assumeRole)
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -104,6 +127,8 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources in parallel.
+// Resources not selected by any filter are not deleted. In dry-run mode the resources are listed but not deleted.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
@@ -115,7 +140,47 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			ok, reason, err := filter.Match(ctx, sweepable)
+
+			if tfresource.NotFound(err) {
+				return nil
+			}
+
+			if err != nil {
+				runReport.addResource(ctx, sweepable, ResourceOutcomeFailed, err)
+				return err
+			}
+
+			if !ok {
+				typeName, id := describe(ctx, sweepable)
+				tflog.Info(ctx, "Skipping resource", map[string]any{
+					loggingKeyResourceType: typeName,
+					"id":                   id,
+					"reason":               reason,
+				})
+				runReport.addResource(ctx, sweepable, ResourceOutcomeFiltered, errors.New(reason))
+				return nil
+			}
+
+			if dryRun {
+				typeName, id := describe(ctx, sweepable)
+				tflog.Info(ctx, "Would sweep resource", map[string]any{
+					loggingKeyResourceType: typeName,
+					"id":                   id,
+				})
+				runReport.addResource(ctx, sweepable, ResourceOutcomeWouldDelete, nil)
+				return nil
+			}
+
+			err = sweepable.Delete(withDeleting(ctx), ThrottlingRetryTimeout, optFns...)
+
+			if err != nil {
+				runReport.addResource(ctx, sweepable, ResourceOutcomeFailed, err)
+			} else {
+				runReport.addResource(ctx, sweepable, ResourceOutcomeDeleted, nil)
+			}
+
+			return err
 		})
 	}
 
@@ -135,11 +200,19 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
+// Register registers a resource sweeper that lists the resources to sweep.
+// The listed resources are deleted by SweepOrchestrator, so the sweeper can be run in dry-run mode
+// and its resources can be listed using List.
+// dependencies are the names of sweepers that must be run before this sweeper.
+// Registering a sweeper whose dependencies introduce a cycle is a fatal error.
 func Register(name string, f SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
-		F: func(region string) error {
+	err := sweepers.add(&sweeper{
+		name:         name,
+		dependencies: dependencies,
+		list:         f,
+		f: func(region string) error {
 			ctx := Context(region)
+			ctx = withSweeper(ctx, name)
 			ctx = logWithResourceType(ctx, name)
 
 			client, err := SharedRegionalSweepClient(ctx, region)
@@ -166,4 +239,8 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 			return nil
 		},
 	})
+
+	if err != nil {
+		log.Fatalf("[ERR] %s", err)
+	}
 }
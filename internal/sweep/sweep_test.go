@@ -7,7 +7,6 @@ import (
 	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
@@ -18,5 +17,5 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
-	resource.TestMain(m)
+	sweep.TestMain(m)
 }
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PolicySeverity is the severity of a tag policy violation.
+type PolicySeverity string
+
+const (
+	PolicySeverityError   PolicySeverity = "error"
+	PolicySeverityWarning PolicySeverity = "warning"
+)
+
+func (PolicySeverity) Values() []PolicySeverity {
+	return []PolicySeverity{
+		PolicySeverityError,
+		PolicySeverityWarning,
+	}
+}
+
+// PolicyConfig contains a tag policy that all resource tags must satisfy.
+type PolicyConfig struct {
+	Keys     []PolicyKey
+	Severity PolicySeverity
+}
+
+// PolicyKey contains the rules for a single tag key.
+type PolicyKey struct {
+	AllowedValues []string
+	Name          string
+	Pattern       *regexp.Regexp
+	Required      bool
+}
+
+// IsWarning returns whether violations of the policy are reported as warnings rather than errors.
+func (pc *PolicyConfig) IsWarning() bool {
+	if pc == nil {
+		return false
+	}
+
+	return pc.Severity == PolicySeverityWarning
+}
+
+// Violations returns a description of each way in which the given tags violate the policy.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+	m := tags.Map()
+
+	for _, key := range pc.Keys {
+		v, ok := m[key.Name]
+
+		if !ok {
+			if key.Required {
+				violations = append(violations, fmt.Sprintf("required tag %q is missing", key.Name))
+			}
+			continue
+		}
+
+		if len(key.AllowedValues) > 0 && !slices.Contains(key.AllowedValues, v) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of [%s]", key.Name, v, strings.Join(key.AllowedValues, ", ")))
+		}
+
+		if key.Pattern != nil && !key.Pattern.MatchString(v) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q does not match pattern %q", key.Name, v, key.Pattern.String()))
+		}
+	}
+
+	return violations
+}
+
+// ViolationsWithSeverity returns a description of each way in which the given tags violate the policy
+// if violations of the policy are reported with the specified severity, otherwise nil.
+func (pc *PolicyConfig) ViolationsWithSeverity(tags KeyValueTags, severity PolicySeverity) []string {
+	if pc.IsWarning() != (severity == PolicySeverityWarning) {
+		return nil
+	}
+
+	return pc.Violations(tags)
+}
+
+// Validate returns an error describing all violations of the policy by the given tags that are reported as errors, or nil.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	violations := pc.ViolationsWithSeverity(tags, PolicySeverityError)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("tags violate the provider tag_policy: %s", strings.Join(violations, "; "))
+}
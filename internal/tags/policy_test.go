@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		Keys: []PolicyKey{
+			{
+				Name:     "CostCenter",
+				Pattern:  regexache.MustCompile(`^\d{4}$`),
+				Required: true,
+			},
+			{
+				AllowedValues: []string{"dev", "prod"},
+				Name:          "Environment",
+			},
+		},
+		Severity: PolicySeverityError,
+	}
+
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		want         []string
+	}{
+		{
+			name: "no policy",
+			tags: New(ctx, map[string]string{}),
+		},
+		{
+			name:         "compliant",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+			}),
+		},
+		{
+			name:         "optional key missing",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+			}),
+		},
+		{
+			name:         "required key missing",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"Environment": "dev",
+			}),
+			want: []string{
+				`required tag "CostCenter" is missing`,
+			},
+		},
+		{
+			name:         "invalid values",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "12345",
+				"Environment": "test",
+			}),
+			want: []string{
+				`tag "CostCenter" value "12345" does not match pattern "^\\d{4}$"`,
+				`tag "Environment" value "test" is not one of [dev, prod]`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Violations(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigIsWarning(t *testing.T) {
+	t.Parallel()
+
+	var policyConfig *PolicyConfig
+
+	if got, want := policyConfig.IsWarning(), false; got != want {
+		t.Errorf("nil IsWarning() = %v, want %v", got, want)
+	}
+
+	policyConfig = &PolicyConfig{Severity: PolicySeverityWarning}
+
+	if got, want := policyConfig.IsWarning(), true; got != want {
+		t.Errorf("IsWarning() = %v, want %v", got, want)
+	}
+}
+
+func TestPolicyConfigViolationsWithSeverity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tags := New(ctx, map[string]string{})
+	keys := []PolicyKey{
+		{
+			Name:     "CostCenter",
+			Required: true,
+		},
+	}
+	violations := []string{
+		`required tag "CostCenter" is missing`,
+	}
+
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		severity     PolicySeverity
+		want         []string
+	}{
+		{
+			name:     "no policy",
+			severity: PolicySeverityError,
+		},
+		{
+			name:         "error policy, error severity",
+			policyConfig: &PolicyConfig{Keys: keys, Severity: PolicySeverityError},
+			severity:     PolicySeverityError,
+			want:         violations,
+		},
+		{
+			name:         "error policy, warning severity",
+			policyConfig: &PolicyConfig{Keys: keys, Severity: PolicySeverityError},
+			severity:     PolicySeverityWarning,
+		},
+		{
+			name:         "warning policy, error severity",
+			policyConfig: &PolicyConfig{Keys: keys, Severity: PolicySeverityWarning},
+			severity:     PolicySeverityError,
+		},
+		{
+			name:         "warning policy, warning severity",
+			policyConfig: &PolicyConfig{Keys: keys, Severity: PolicySeverityWarning},
+			severity:     PolicySeverityWarning,
+			want:         violations,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.ViolationsWithSeverity(tags, testCase.severity)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -9,7 +9,8 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -31,7 +32,7 @@ func TestNotFound(t *testing.T) {
 		},
 		{
 			Name:     "not found error",
-			Err:      &retry.NotFoundError{LastError: errors.New("test")},
+			Err:      &sdkretry.NotFoundError{LastError: errors.New("test")},
 			Expected: true,
 		},
 		{
@@ -40,9 +41,24 @@ func TestNotFound(t *testing.T) {
 		},
 		{
 			Name:     "wrapped not found error",
+			Err:      fmt.Errorf("test: %w", &sdkretry.NotFoundError{LastError: errors.New("test")}),
+			Expected: true,
+		},
+		{
+			Name:     "internal not found error",
+			Err:      &retry.NotFoundError{LastError: errors.New("test")},
+			Expected: true,
+		},
+		{
+			Name:     "wrapped internal not found error",
 			Err:      fmt.Errorf("test: %w", &retry.NotFoundError{LastError: errors.New("test")}),
 			Expected: true,
 		},
+		{
+			Name:     "empty result error",
+			Err:      tfresource.NewEmptyResultError(nil),
+			Expected: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -77,12 +93,12 @@ func TestTimedOut(t *testing.T) {
 		},
 		{
 			Name:     "timeout error",
-			Err:      &retry.TimeoutError{},
+			Err:      &sdkretry.TimeoutError{},
 			Expected: true,
 		},
 		{
 			Name: "timeout error non-nil last error",
-			Err:  &retry.TimeoutError{LastError: errors.New("test")},
+			Err:  &sdkretry.TimeoutError{LastError: errors.New("test")},
 		},
 		{
 			Name: "wrapped other error",
@@ -90,11 +106,24 @@ func TestTimedOut(t *testing.T) {
 		},
 		{
 			Name: "wrapped timeout error",
-			Err:  fmt.Errorf("test: %w", &retry.TimeoutError{}),
+			Err:  fmt.Errorf("test: %w", &sdkretry.TimeoutError{}),
 		},
 		{
 			Name: "wrapped timeout error non-nil last error",
-			Err:  fmt.Errorf("test: %w", &retry.TimeoutError{LastError: errors.New("test")}),
+			Err:  fmt.Errorf("test: %w", &sdkretry.TimeoutError{LastError: errors.New("test")}),
+		},
+		{
+			Name:     "internal timeout error",
+			Err:      &retry.TimeoutError{},
+			Expected: true,
+		},
+		{
+			Name: "internal timeout error non-nil last error",
+			Err:  &retry.TimeoutError{LastError: errors.New("test")},
+		},
+		{
+			Name: "wrapped internal timeout error",
+			Err:  fmt.Errorf("test: %w", &retry.TimeoutError{}),
 		},
 	}
 
@@ -131,39 +160,61 @@ func TestSetLastError(t *testing.T) {
 		},
 		{
 			Name: "timeout error lastErr is nil",
-			Err:  &retry.TimeoutError{},
+			Err:  &sdkretry.TimeoutError{},
 		},
 		{
 			Name:     "timeout error",
-			Err:      &retry.TimeoutError{},
+			Err:      &sdkretry.TimeoutError{},
 			LastErr:  errors.New("lasttest"),
 			Expected: true,
 		},
 		{
 			Name: "timeout error non-nil last error lastErr is nil",
-			Err:  &retry.TimeoutError{LastError: errors.New("test")},
+			Err:  &sdkretry.TimeoutError{LastError: errors.New("test")},
 		},
 		{
 			Name:    "timeout error non-nil last error no overwrite",
-			Err:     &retry.TimeoutError{LastError: errors.New("test")},
+			Err:     &sdkretry.TimeoutError{LastError: errors.New("test")},
 			LastErr: errors.New("lasttest"),
 		},
 		{
 			Name: "unexpected state error lastErr is nil",
-			Err:  &retry.UnexpectedStateError{},
+			Err:  &sdkretry.UnexpectedStateError{},
 		},
 		{
 			Name:     "unexpected state error",
-			Err:      &retry.UnexpectedStateError{},
+			Err:      &sdkretry.UnexpectedStateError{},
 			LastErr:  errors.New("lasttest"),
 			Expected: true,
 		},
 		{
 			Name: "unexpected state error non-nil last error lastErr is nil",
-			Err:  &retry.UnexpectedStateError{LastError: errors.New("test")},
+			Err:  &sdkretry.UnexpectedStateError{LastError: errors.New("test")},
 		},
 		{
 			Name:    "unexpected state error non-nil last error no overwrite",
+			Err:     &sdkretry.UnexpectedStateError{LastError: errors.New("test")},
+			LastErr: errors.New("lasttest"),
+		},
+		{
+			Name:     "internal timeout error",
+			Err:      &retry.TimeoutError{},
+			LastErr:  errors.New("lasttest"),
+			Expected: true,
+		},
+		{
+			Name:    "internal timeout error non-nil last error no overwrite",
+			Err:     &retry.TimeoutError{LastError: errors.New("test")},
+			LastErr: errors.New("lasttest"),
+		},
+		{
+			Name:     "internal unexpected state error",
+			Err:      &retry.UnexpectedStateError{},
+			LastErr:  errors.New("lasttest"),
+			Expected: true,
+		},
+		{
+			Name:    "internal unexpected state error non-nil last error no overwrite",
 			Err:     &retry.UnexpectedStateError{LastError: errors.New("test")},
 			LastErr: errors.New("lasttest"),
 		},
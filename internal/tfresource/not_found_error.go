@@ -7,7 +7,8 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 )
@@ -34,17 +35,25 @@ func (e *EmptyResultError) Is(err error) bool {
 }
 
 func (e *EmptyResultError) As(target interface{}) bool {
-	t, ok := target.(**retry.NotFoundError)
-	if !ok {
-		return false
-	}
+	switch t := target.(type) {
+	case **sdkretry.NotFoundError:
+		*t = &sdkretry.NotFoundError{
+			Message:     e.Error(),
+			LastRequest: e.LastRequest,
+		}
+
+		return true
+
+	case **retry.NotFoundError:
+		*t = &retry.NotFoundError{
+			Message:     e.Error(),
+			LastRequest: e.LastRequest,
+		}
 
-	*t = &retry.NotFoundError{
-		Message:     e.Error(),
-		LastRequest: e.LastRequest,
+		return true
 	}
 
-	return true
+	return false
 }
 
 type TooManyResultsError struct {
@@ -71,17 +80,25 @@ func (e *TooManyResultsError) Is(err error) bool {
 }
 
 func (e *TooManyResultsError) As(target interface{}) bool {
-	t, ok := target.(**retry.NotFoundError)
-	if !ok {
-		return false
-	}
+	switch t := target.(type) {
+	case **sdkretry.NotFoundError:
+		*t = &sdkretry.NotFoundError{
+			Message:     e.Error(),
+			LastRequest: e.LastRequest,
+		}
+
+		return true
+
+	case **retry.NotFoundError:
+		*t = &retry.NotFoundError{
+			Message:     e.Error(),
+			LastRequest: e.LastRequest,
+		}
 
-	*t = &retry.NotFoundError{
-		Message:     e.Error(),
-		LastRequest: e.LastRequest,
+		return true
 	}
 
-	return true
+	return false
 }
 
 // SingularDataSourceFindError returns a standard error message for a singular data source's non-nil resource find error.
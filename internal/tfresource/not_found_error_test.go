@@ -9,7 +9,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 func TestEmptyResultErrorAsNotFoundError(t *testing.T) {
@@ -18,6 +19,26 @@ func TestEmptyResultErrorAsNotFoundError(t *testing.T) {
 	lastRequest := 123
 	err := NewEmptyResultError(lastRequest)
 
+	var nfe *sdkretry.NotFoundError
+	ok := errors.As(err, &nfe)
+
+	if !ok {
+		t.Fatal("expected errors.As() to return true")
+	}
+	if nfe.Message != "empty result" {
+		t.Errorf(`expected Message to be "empty result", got %q`, nfe.Message)
+	}
+	if nfe.LastRequest != lastRequest {
+		t.Errorf("unexpected value for LastRequest")
+	}
+}
+
+func TestEmptyResultErrorAsInternalNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	lastRequest := 123
+	err := NewEmptyResultError(lastRequest)
+
 	var nfe *retry.NotFoundError
 	ok := errors.As(err, &nfe)
 
@@ -99,7 +120,7 @@ func TestTooManyResultsErrorAsNotFoundError(t *testing.T) {
 	lastRequest := 123
 	err := NewTooManyResultsError(count, lastRequest)
 
-	var nfe *retry.NotFoundError
+	var nfe *sdkretry.NotFoundError
 	ok := errors.As(err, &nfe)
 
 	if !ok {
@@ -8,13 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
-	"sync"
 	"time"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -60,25 +59,13 @@ func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}
 // RetryGWhen is the generic version of RetryWhen which obviates the need for a type
 // assertion after the call. It retries the function `f` when the error it returns
 // satisfies `retryable`. `f` is retried until `timeout` expires.
+// RetryGWhen does not depend on the Plugin SDK v2.
 func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
-	var output T
+	output, err := retryG(ctx, timeout, func() (T, bool, error) {
+		output, err := f()
+		again, err := retryable(err)
 
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-		var again bool
-
-		output, err = f()
-		again, err = retryable(err)
-
-		if again {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
+		return output, again, err
 	})
 
 	if TimedOut(err) {
@@ -152,6 +139,17 @@ func RetryGWhenMessageContains[T any](ctx context.Context, timeout time.Duration
 	})
 }
 
+// RetryGWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
+func RetryGWhenAWSErrCodeEquals[T any](ctx context.Context, timeout time.Duration, f func() (T, error), codes ...string) (T, error) { // nosemgrep:ci.aws-in-func-name
+	return RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, codes...) || tfawserr_sdkv2.ErrCodeEquals(err, codes...) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
 func RetryWhenIsA[T error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if errs.IsA[T](err) {
@@ -182,6 +180,28 @@ func RetryWhenIsOneOf3[T1, T2, T3 error](ctx context.Context, timeout time.Durat
 	})
 }
 
+// RetryGWhenIsA retries the specified function when it returns an error of type `E`.
+func RetryGWhenIsA[T any, E error](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if errs.IsA[E](err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryGWhenIsOneOf2 retries the specified function when it returns an error of type `E1` or `E2`.
+func RetryGWhenIsOneOf2[T any, E1, E2 error](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if errs.IsA[E1](err) || errs.IsA[E2](err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
 func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.Context, timeout time.Duration, f func() (interface{}, error), needle string) (interface{}, error) {
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if errs.IsAErrorMessageContains[T](err, needle) {
@@ -194,22 +214,18 @@ func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.
 
 // RetryUntilEqual retries the specified function until it returns a value equal to `t`.
 func RetryUntilEqual[T comparable](ctx context.Context, timeout time.Duration, t T, f func() (T, error)) (T, error) {
-	var output T
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-
-		output, err = f()
+	output, err := retryG(ctx, timeout, func() (T, bool, error) {
+		output, err := f()
 
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return output, false, err
 		}
 
 		if output != t {
-			return retry.RetryableError(fmt.Errorf("output = %v, want %v", output, t))
+			return output, true, fmt.Errorf("output = %v, want %v", output, t)
 		}
 
-		return nil
+		return output, false, nil
 	})
 
 	if TimedOut(err) {
@@ -278,6 +294,21 @@ func RetryGWhenNotFound[T any](ctx context.Context, timeout time.Duration, f fun
 	})
 }
 
+// RetryGUntilNotFound retries the specified function until it returns a retry.NotFoundError.
+func RetryGUntilNotFound[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return true, ErrFoundResource
+	})
+}
+
 // RetryWhenNewResourceNotFound retries the specified function when it returns a retry.NotFoundError and `isNewResource` is true.
 func RetryWhenNewResourceNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
@@ -362,59 +393,63 @@ func WithContinuousTargetOccurence(continuousTargetOccurence int) OptionsFunc {
 // This is especially useful for AWS services that are prone to throttling, such as Route53, where
 // the default durations cause problems.
 func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns ...OptionsFunc) error {
-	// These are used to pull the error out of the function; need a mutex to
-	// avoid a data race.
-	var resultErr error
-	var resultErrMu sync.Mutex
-
 	options := Options{}
 	for _, fn := range optFns {
 		fn(&options)
 	}
 
 	c := &retry.StateChangeConf{
-		Pending:    []string{"retryableerror"},
-		Target:     []string{"success"},
 		Timeout:    timeout,
 		MinTimeout: 500 * time.Millisecond,
-		Refresh: func() (interface{}, string, error) {
-			rerr := f()
+	}
 
-			resultErrMu.Lock()
-			defer resultErrMu.Unlock()
+	options.Apply(c)
 
-			if rerr == nil {
-				resultErr = nil
-				return 42, "success", nil
-			}
+	return retry.RetryContextWithConf(ctx, c, f)
+}
 
-			resultErr = rerr.Err
+// retryG retries the function `f` while it returns `again` until `timeout` expires.
+// It is the equivalent of Retry built on the internal retry package.
+// If the wait times out, the last error returned by `f` takes precedence over the timeout error.
+func retryG[T any](ctx context.Context, timeout time.Duration, f func() (output T, again bool, err error)) (T, error) {
+	const (
+		stateRetryable = "retryableerror"
+		stateSuccess   = "success"
+	)
+	var (
+		output    T
+		resultErr error
+	)
+
+	c := &retry.StateChangeConfOf[bool, string]{
+		Pending:    []string{stateRetryable},
+		Target:     []string{stateSuccess},
+		Timeout:    timeout,
+		MinTimeout: 500 * time.Millisecond,
+		Refresh: func(context.Context) (bool, string, error) {
+			var again bool
+
+			output, again, resultErr = f()
+
+			if again {
+				return true, stateRetryable, nil
+			}
 
-			if rerr.Retryable {
-				return 42, "retryableerror", nil
+			if resultErr != nil {
+				return false, "", resultErr
 			}
 
-			return nil, "quit", rerr.Err
+			return true, stateSuccess, nil
 		},
 	}
 
-	options.Apply(c)
-
-	_, waitErr := c.WaitForStateContext(ctx)
+	_, err := c.WaitForStateContext(ctx)
 
-	// Need to acquire the lock here to be able to avoid race using resultErr as
-	// the return value
-	resultErrMu.Lock()
-	defer resultErrMu.Unlock()
-
-	// resultErr may be nil because the wait timed out and resultErr was never
-	// set; this is still an error
-	if resultErr == nil {
-		return waitErr
+	if resultErr != nil {
+		return output, resultErr
 	}
-	// resultErr takes precedence over waitErr if both are set because it is
-	// more likely to be useful
-	return resultErr
+
+	return output, err
 }
 
 type deadline time.Time
@@ -11,8 +11,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -373,6 +373,137 @@ func TestRetryUntilNotFound(t *testing.T) { //nolint:tparallel
 	}
 }
 
+func TestRetryGUntilNotFound(t *testing.T) { //nolint:tparallel
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (*int, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (*int, error) {
+				return nil, nil
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "other error",
+			F: func() (*int, error) {
+				return nil, errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "NotFoundError",
+			F: func() (*int, error) {
+				return nil, &retry.NotFoundError{}
+			},
+		},
+		{
+			Name: "SDK NotFoundError",
+			F: func() (*int, error) {
+				return nil, &retry.NotFoundError{}
+			},
+		},
+		{
+			Name: "retryable NotFoundError",
+			F: func() (*int, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, nil
+				}
+
+				return nil, &retry.NotFoundError{}
+			},
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := tfresource.RetryGUntilNotFound(ctx, 5*time.Second, testCase.F)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestRetryUntilEqual(t *testing.T) { //nolint:tparallel
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (int, error)
+		Expected    int
+		ExpectError bool
+	}{
+		{
+			Name: "equal",
+			F: func() (int, error) {
+				return 42, nil
+			},
+			Expected: 42,
+		},
+		{
+			Name: "error",
+			F: func() (int, error) {
+				return 0, errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "never equal",
+			F: func() (int, error) {
+				return 1, nil
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retry then equal",
+			F: func() (int, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return 1, nil
+				}
+
+				return 42, nil
+			},
+			Expected: 42,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			got, err := tfresource.RetryUntilEqual(ctx, 5*time.Second, 42, testCase.F)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestRetryContext_error(t *testing.T) {
 	ctx := acctest.Context(t)
 	t.Parallel()
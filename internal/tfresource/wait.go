@@ -7,7 +7,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 type WaitOpts struct {
@@ -28,21 +28,21 @@ const (
 // If `timeout` is exceeded before `f` returns `true`, return an error.
 // Waits between calls to `f` using exponential backoff, except when waiting for the target state to reoccur.
 func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
-	refresh := func() (interface{}, string, error) {
+	refresh := func(context.Context) (bool, string, error) {
 		done, err := f()
 
 		if err != nil {
-			return nil, targetStateError, err
+			return false, targetStateError, err
 		}
 
 		if done {
-			return "", targetStateTrue, nil
+			return true, targetStateTrue, nil
 		}
 
-		return "", targetStateFalse, nil
+		return false, targetStateFalse, nil
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &retry.StateChangeConfOf[bool, string]{
 		Pending:                   []string{targetStateFalse},
 		Target:                    []string{targetStateTrue},
 		Refresh:                   refresh,
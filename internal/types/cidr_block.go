@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"net"
 )
 
@@ -52,3 +53,69 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// AllocateCIDRBlocks allocates a CIDR block with each of the specified prefix lengths from the parent CIDR block.
+// Blocks are allocated in order, each at the lowest address that is aligned to the block's size and
+// that does not overlap any previously allocated block, as IPAM does. Allocation is deterministic and
+// appending prefix lengths does not change the blocks allocated for earlier prefix lengths.
+// An error is returned if the parent CIDR block has no room for a block.
+func AllocateCIDRBlocks(parent string, prefixLengths []int) ([]string, error) {
+	_, ipnet, err := net.ParseCIDR(parent)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid CIDR block: %w", parent, err)
+	}
+
+	ip := ipnet.IP
+	if v := ip.To4(); v != nil {
+		ip = v
+	}
+	parentLength, bits := ipnet.Mask.Size()
+	base := new(big.Int).SetBytes(ip)
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-parentLength))
+
+	type block struct {
+		start, end *big.Int // Offsets from the parent's network address, end exclusive.
+	}
+	var allocated []block
+	var cidrs []string
+
+	for _, prefixLength := range prefixLengths {
+		if prefixLength < parentLength || prefixLength > bits {
+			return nil, fmt.Errorf("prefix length (%d) must be between %d and %d", prefixLength, parentLength, bits)
+		}
+
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+		start := new(big.Int)
+
+		for {
+			// Align the candidate block to its size.
+			if r := new(big.Int).Mod(start, size); r.Sign() != 0 {
+				start.Add(start, size).Sub(start, r)
+			}
+			end := new(big.Int).Add(start, size)
+
+			if end.Cmp(limit) > 0 {
+				return nil, fmt.Errorf("%q has no room for a /%d CIDR block", parent, prefixLength)
+			}
+
+			overlap := false
+			for _, b := range allocated {
+				if start.Cmp(b.end) < 0 && b.start.Cmp(end) < 0 {
+					start.Set(b.end)
+					overlap = true
+					break
+				}
+			}
+
+			if !overlap {
+				allocated = append(allocated, block{start: start, end: end})
+				break
+			}
+		}
+
+		addr := new(big.Int).Add(base, start).FillBytes(make([]byte, len(ip)))
+		cidrs = append(cidrs, (&net.IPNet{IP: addr, Mask: net.CIDRMask(prefixLength, bits)}).String())
+	}
+
+	return cidrs, nil
+}
@@ -3,7 +3,11 @@
 
 package types
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
 
 func TestValidateCIDRBlock(t *testing.T) {
 	t.Parallel()
@@ -72,3 +76,35 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestAllocateCIDRBlocks(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		parent        string
+		prefixLengths []int
+		expected      []string
+		valid         bool
+	}{
+		{"10.0.0.0/16", []int{24, 24, 24}, []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"}, true},
+		// The /20 is aligned after the /24, and the gap before it is filled by the following /24.
+		{"10.0.0.0/16", []int{24, 20, 24}, []string{"10.0.0.0/24", "10.0.16.0/20", "10.0.1.0/24"}, true},
+		{"10.0.0.0/24", []int{25, 25}, []string{"10.0.0.0/25", "10.0.0.128/25"}, true},
+		{"10.0.0.0/24", []int{25, 25, 28}, nil, false},
+		{"10.0.0.0/24", []int{16}, nil, false},
+		{"2001:db8:1234:1a00::/56", []int{64, 64, 64}, []string{"2001:db8:1234:1a00::/64", "2001:db8:1234:1a01::/64", "2001:db8:1234:1a02::/64"}, true},
+		{"2001:db8:1234:1a00::/63", []int{64, 64, 64}, nil, false},
+		{"", []int{24}, nil, false},
+	} {
+		got, err := AllocateCIDRBlocks(ts.parent, ts.prefixLengths)
+		if !ts.valid && err == nil {
+			t.Fatalf("Input '%s' %v should error but didn't!", ts.parent, ts.prefixLengths)
+		}
+		if ts.valid && err != nil {
+			t.Fatalf("Got unexpected error for '%s' %v input: %s", ts.parent, ts.prefixLengths, err)
+		}
+		if diff := cmp.Diff(got, ts.expected); diff != "" {
+			t.Fatalf("unexpected diff for '%s' %v (+wanted, -got): %s", ts.parent, ts.prefixLengths, diff)
+		}
+	}
+}
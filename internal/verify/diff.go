@@ -42,6 +42,11 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	// Violations of a warning-severity tag policy are reported during plan by the provider server, as CustomizeDiff cannot return warnings.
+	if err := meta.(*conns.AWSClient).TagPolicyConfig.Validate(allTags); err != nil {
+		return err
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
@@ -5,10 +5,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -38,6 +40,8 @@ func main() {
 		serveOpts...,
 	)
 
+	err = errors.Join(err, conns.CloseAuditLogs())
+
 	if err != nil {
 		log.Fatal(err)
 	}
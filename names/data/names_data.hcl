@@ -9771,12 +9771,11 @@ service "mediapackagev2" {
   }
 
   resource_prefix {
-    actual  = "aws_media_packagev2_"
     correct = "aws_mediapackagev2_"
   }
 
   provider_package_correct = "mediapackagev2"
-  doc_prefix               = ["media_packagev2_"]
+  doc_prefix               = ["mediapackagev2_"]
   brand                    = "AWS"
 }
 
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func testService(t *testing.T) *Service {
+	t.Helper()
+
+	s, err := ParseService("github.com/aws/aws-sdk-go-v2/service/example", filepath.Join("testdata", "example"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func TestParseService(t *testing.T) {
+	t.Parallel()
+
+	s := testService(t)
+
+	if got, want := s.PackageName, "example"; got != want {
+		t.Errorf("PackageName = %q, want %q", got, want)
+	}
+
+	if got, want := s.ServiceID, "Example Service"; got != want {
+		t.Errorf("ServiceID = %q, want %q", got, want)
+	}
+
+	if got, want := s.Paginators(), []string{"ListWidgets"}; !slices.Equal(got, want) {
+		t.Errorf("Paginators = %v, want %v", got, want)
+	}
+
+	for _, operation := range []string{"CreateWidget", "GetWidget", "UpdateWidget", "DeleteWidget", "ListWidgets"} {
+		if !s.HasOperation(operation) {
+			t.Errorf("expected operation %q", operation)
+		}
+	}
+	if s.HasOperation("CreateGadget") {
+		t.Error("unexpected operation CreateGadget")
+	}
+	if !s.HasPaginator("ListWidgets") {
+		t.Error("expected ListWidgets paginator")
+	}
+
+	input := s.Shape("CreateWidgetInput")
+	if input == nil {
+		t.Fatal("expected shape CreateWidgetInput")
+	}
+	if got, want := len(input.Members), 5; got != want {
+		t.Errorf("CreateWidgetInput members = %d, want %d", got, want)
+	}
+	if member := input.Member("Name"); member == nil || !member.Required || member.Type.Kind != KindString || member.Type.GoType != "*string" {
+		t.Errorf("unexpected CreateWidgetInput.Name: %+v", member)
+	}
+	if member := input.Member("Configuration"); member == nil || member.Required || member.Type.Kind != KindStructure || member.Type.Name != "types.WidgetConfiguration" || member.Type.GoType != "*awstypes.WidgetConfiguration" {
+		t.Errorf("unexpected CreateWidgetInput.Configuration: %+v", member)
+	}
+	if member := input.Member("Tags"); member == nil || member.Type.Kind != KindMap || member.Type.Elem.Kind != KindString {
+		t.Errorf("unexpected CreateWidgetInput.Tags: %+v", member)
+	}
+
+	widget := s.Shape("types.Widget")
+	if member := widget.Member("Status"); member == nil || member.Type.Kind != KindEnum || member.Type.GoType != "awstypes.WidgetStatus" {
+		t.Errorf("unexpected Widget.Status: %+v", member)
+	}
+	if member := widget.Member("CreatedAt"); member == nil || member.Type.Kind != KindTimestamp {
+		t.Errorf("unexpected Widget.CreatedAt: %+v", member)
+	}
+
+	configuration := s.Shape("types.WidgetConfiguration")
+	if member := configuration.Member("Size"); member == nil || !member.Required || member.Type.Kind != KindInteger {
+		t.Errorf("unexpected WidgetConfiguration.Size: %+v", member)
+	}
+	if member := configuration.Member("Parts"); member == nil || member.Type.Kind != KindList || member.Type.Elem.Kind != KindStructure || member.Type.GoType != "[]awstypes.WidgetPart" {
+		t.Errorf("unexpected WidgetConfiguration.Parts: %+v", member)
+	}
+	if member := configuration.Member("Unknown"); member == nil || member.Type.Kind != KindUnsupported {
+		t.Errorf("unexpected WidgetConfiguration.Unknown: %+v", member)
+	}
+
+	if got, want := s.EnumValues("types.WidgetStatus"), []EnumValue{
+		{ConstName: "WidgetStatusCreating", Value: "CREATING"},
+		{ConstName: "WidgetStatusActive", Value: "ACTIVE"},
+		{ConstName: "WidgetStatusUpdating", Value: "UPDATING"},
+		{ConstName: "WidgetStatusDeleting", Value: "DELETING"},
+		{ConstName: "WidgetStatusCreateFailed", Value: "CREATE_FAILED"},
+	}; !slices.Equal(got, want) {
+		t.Errorf("EnumValues = %v, want %v", got, want)
+	}
+}
+
+func TestResource(t *testing.T) {
+	t.Parallel()
+
+	s := testService(t)
+
+	if _, err := s.Resource("GetWidget"); err == nil {
+		t.Error("expected error, got none")
+	}
+	if _, err := s.Resource("CreateGadget"); err == nil {
+		t.Error("expected error, got none")
+	}
+
+	r, err := s.Resource("CreateWidget")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, testCase := range []struct {
+		name      string
+		got, want string
+	}{
+		{"Name", r.Name, "Widget"},
+		{"Read", r.Read, "GetWidget"},
+		{"Update", r.Update, "UpdateWidget"},
+		{"Delete", r.Delete, "DeleteWidget"},
+		{"List", r.List, "ListWidgets"},
+		{"ReadOutputMember", r.ReadOutputMember, "Widget"},
+		{"Shape", r.Shape.Name, "types.Widget"},
+		{"Identifier", r.Identifier, "Id"},
+		{"ReadInputIdentifier", r.ReadInputIdentifier, "WidgetIdentifier"},
+		{"UpdateInputIdentifier", r.UpdateInputIdentifier, "WidgetIdentifier"},
+		{"DeleteInputIdentifier", r.DeleteInputIdentifier, "WidgetIdentifier"},
+		{"ListOutputMember", r.ListOutputMember, "Widgets"},
+		{"ListIdentifier", r.ListIdentifier, "Id"},
+	} {
+		if testCase.got != testCase.want {
+			t.Errorf("%s = %q, want %q", testCase.name, testCase.got, testCase.want)
+		}
+	}
+
+	if got, want := r.CreateOutputIdentifier, []string{"Widget", "Id"}; !slices.Equal(got, want) {
+		t.Errorf("CreateOutputIdentifier = %v, want %v", got, want)
+	}
+	if !r.ClientToken {
+		t.Error("expected ClientToken")
+	}
+	if !r.Tags {
+		t.Error("expected Tags")
+	}
+
+	type attribute struct {
+		name                                                              string
+		required, optional, computed, requiresReplace, useStateForUnknown bool
+	}
+	var got []attribute
+	for _, v := range r.Attributes {
+		got = append(got, attribute{v.Name, v.Required, v.Optional, v.Computed, v.RequiresReplace, v.UseStateForUnknown})
+	}
+	want := []attribute{
+		{name: "Name", required: true, requiresReplace: true},
+		{name: "Description", optional: true, computed: true},
+		{name: "Configuration", optional: true, computed: true, requiresReplace: true},
+		{name: "Arn", computed: true, useStateForUnknown: true},
+		{name: "Id", computed: true, useStateForUnknown: true},
+		{name: "CreatedAt", computed: true, useStateForUnknown: true},
+		{name: "Status", computed: true},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Attributes = %+v, want %+v", got, want)
+	}
+
+	configuration := r.Attributes[2]
+	if got, want := len(configuration.Attributes), 4; got != want {
+		t.Fatalf("Configuration attributes = %d, want %d", got, want)
+	}
+	if size := configuration.Attributes[0]; size.Name != "Size" || !size.Required {
+		t.Errorf("unexpected Configuration.Size: %+v", size)
+	}
+	parts := configuration.Attributes[2]
+	if got, want := len(parts.Attributes), 2; got != want {
+		t.Fatalf("Configuration.Parts attributes = %d, want %d", got, want)
+	}
+	// Recursive structures are truncated.
+	if parent := parts.Attributes[1]; parent.Name != "Parent" || parent.Attributes != nil {
+		t.Errorf("unexpected Configuration.Parts.Parent: %+v", parent)
+	}
+
+	if r.Status == nil {
+		t.Fatal("expected Status")
+	}
+	for _, testCase := range []struct {
+		name      string
+		got, want []EnumValue
+	}{
+		{"CreatePending", r.Status.CreatePending, []EnumValue{{ConstName: "WidgetStatusCreating", Value: "CREATING"}}},
+		{"Target", r.Status.Target, []EnumValue{{ConstName: "WidgetStatusActive", Value: "ACTIVE"}}},
+		{"UpdatePending", r.Status.UpdatePending, []EnumValue{{ConstName: "WidgetStatusUpdating", Value: "UPDATING"}}},
+		{"DeletePending", r.Status.DeletePending, []EnumValue{{ConstName: "WidgetStatusDeleting", Value: "DELETING"}}},
+	} {
+		if !slices.Equal(testCase.got, testCase.want) {
+			t.Errorf("Status.%s = %v, want %v", testCase.name, testCase.got, testCase.want)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Resource is a resource derived from the API model, starting from the operation that creates it.
+type Resource struct {
+	Name string // e.g. "Widget"
+
+	// Operation names. Any may be empty if the API has no corresponding operation.
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+
+	CreateInput *Shape
+	ReadInput   *Shape
+	UpdateInput *Shape
+	DeleteInput *Shape
+
+	// ReadOutputMember is the member of the read operation's output holding the resource, e.g. "Widget".
+	// If empty the finder returns the whole output.
+	ReadOutputMember string
+	// Shape is the finder's result structure.
+	Shape *Shape
+
+	// Identifier is the member of Shape uniquely identifying the resource, e.g. "WidgetArn".
+	Identifier string
+	// CreateOutputIdentifier is the path to the identifier in the create operation's output, e.g. ["Widget", "WidgetArn"].
+	CreateOutputIdentifier []string
+	// The members of the read, update and delete operations' inputs identifying the resource, e.g. "WidgetIdentifier".
+	ReadInputIdentifier   string
+	UpdateInputIdentifier string
+	DeleteInputIdentifier string
+
+	// ListOutputMember is the member of the list operation's output holding the resource summaries, e.g. "Widgets".
+	ListOutputMember string
+	// ListIdentifier is the member of a resource summary uniquely identifying the resource.
+	ListIdentifier string
+
+	Attributes []*Attribute
+
+	ClientToken bool
+	Tags        bool
+
+	Status *Status
+}
+
+// Attribute is a resource attribute derived from API structure members.
+type Attribute struct {
+	Name string // API member name, e.g. "WidgetArn"
+	Type *Type
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	// UseStateForUnknown is set for computed values that don't change once the resource has been created.
+	UseStateForUnknown bool
+
+	// Attributes is set for structure types (and lists of structures).
+	Attributes []*Attribute
+}
+
+// Status is how the resource's status is reported.
+type Status struct {
+	Member string // e.g. "Status"
+	Type   string // e.g. "types.WidgetStatus"
+
+	CreatePending []EnumValue
+	Target        []EnumValue
+	UpdatePending []EnumValue
+	DeletePending []EnumValue
+}
+
+var (
+	// skippedMembers are API members never mapped to attributes.
+	skippedMembers = map[string]bool{
+		"ClientToken":    true,
+		"DryRun":         true,
+		"MaxResults":     true,
+		"NextToken":      true,
+		"ResultMetadata": true,
+		"Tags":           true,
+	}
+
+	// Normalized status values.
+	createPendingStatuses = []string{"CREATING", "PENDING", "PROVISIONING", "STARTING", "INPROGRESS"}
+	targetStatuses        = []string{"ACTIVE", "AVAILABLE", "READY", "CREATED", "RUNNING", "HEALTHY", "ENABLED", "SUCCEEDED", "COMPLETED"}
+	updatePendingStatuses = []string{"UPDATING", "MODIFYING", "UPDATEINPROGRESS"}
+	deletePendingStatuses = []string{"DELETING", "DELETEINPROGRESS"}
+)
+
+// Resource derives a resource from the named create operation, e.g. "CreateWidget".
+func (s *Service) Resource(createOperation string) (*Resource, error) {
+	name, ok := strings.CutPrefix(createOperation, "Create")
+
+	if !ok || name == "" {
+		return nil, fmt.Errorf("%q is not a create operation", createOperation)
+	}
+
+	if !s.HasOperation(createOperation) {
+		return nil, fmt.Errorf("%s has no operation %q", s.ImportPath, createOperation)
+	}
+
+	r := &Resource{
+		Name:        name,
+		Create:      createOperation,
+		CreateInput: s.Shape(createOperation + "Input"),
+	}
+
+	if r.CreateInput == nil {
+		return nil, fmt.Errorf("%s has no shape %q", s.ImportPath, createOperation+"Input")
+	}
+
+	r.Read = s.firstOperation("Get"+name, "Describe"+name)
+	r.Update = s.firstOperation("Update"+name, "Modify"+name)
+	r.Delete = s.firstOperation("Delete" + name)
+	if list := s.firstOperation(plurals("List" + name)...); s.HasPaginator(list) {
+		r.List = list
+	}
+
+	r.ReadInput = s.Shape(r.Read + "Input")
+	r.UpdateInput = s.Shape(r.Update + "Input")
+	r.DeleteInput = s.Shape(r.Delete + "Input")
+
+	if output := s.Shape(r.Read + "Output"); output != nil {
+		r.Shape = output
+		if member := output.Member(name); member != nil && member.Type.Kind == KindStructure {
+			r.ReadOutputMember = member.Name
+			r.Shape = s.Shape(member.Type.Name)
+		}
+	}
+
+	r.ReadInputIdentifier = identifierMember(r.ReadInput)
+	r.UpdateInputIdentifier = identifierMember(r.UpdateInput)
+	r.DeleteInputIdentifier = identifierMember(r.DeleteInput)
+
+	if r.Shape.Member(r.ReadInputIdentifier) != nil {
+		r.Identifier = r.ReadInputIdentifier
+	} else {
+		for _, v := range []string{name + "Id", "Id", name + "Arn", "Arn", name + "Name", "Name"} {
+			if r.Shape.Member(v) != nil {
+				r.Identifier = v
+				break
+			}
+		}
+	}
+
+	if output := s.Shape(createOperation + "Output"); output != nil && r.Identifier != "" {
+		if output.Member(r.Identifier) != nil {
+			r.CreateOutputIdentifier = []string{r.Identifier}
+		} else {
+			for _, member := range output.Members {
+				if member.Type.Kind == KindStructure && s.Shape(member.Type.Name).Member(r.Identifier) != nil {
+					r.CreateOutputIdentifier = []string{member.Name, r.Identifier}
+					break
+				}
+			}
+		}
+	}
+
+	if output := s.Shape(r.List + "Output"); output != nil {
+		for _, member := range output.Members {
+			if member.Type.Kind == KindList && member.Type.Elem.Kind == KindStructure {
+				r.ListOutputMember = member.Name
+				summary := s.Shape(member.Type.Elem.Name)
+				for _, v := range []string{r.Identifier, name + "Arn", "Arn", name + "Id", "Id"} {
+					if v != "" && summary.Member(v) != nil {
+						r.ListIdentifier = v
+						break
+					}
+				}
+				break
+			}
+		}
+	}
+
+	r.ClientToken = r.CreateInput.Member("ClientToken") != nil
+	r.Tags = r.CreateInput.Member("Tags") != nil
+
+	r.Attributes = s.attributes(r)
+
+	if member := r.Shape.Member("Status"); member != nil && member.Type.Kind == KindEnum {
+		values := s.EnumValues(member.Type.Name)
+		r.Status = &Status{
+			Member:        member.Name,
+			Type:          member.Type.Name,
+			CreatePending: matchStatuses(values, createPendingStatuses),
+			Target:        matchStatuses(values, targetStatuses),
+			UpdatePending: matchStatuses(values, updatePendingStatuses),
+			DeletePending: matchStatuses(values, deletePendingStatuses),
+		}
+	}
+
+	return r, nil
+}
+
+// attributes returns the resource's top-level attributes, the union of the create operation's input members and
+// the finder result's members, in that order.
+func (s *Service) attributes(r *Resource) []*Attribute {
+	var attributes []*Attribute
+	seen := make(map[string]bool)
+
+	updatable := func(name string) bool {
+		return r.UpdateInput.Member(name) != nil
+	}
+
+	for _, member := range r.CreateInput.Members {
+		if skippedMembers[member.Name] {
+			continue
+		}
+		seen[member.Name] = true
+
+		attribute := &Attribute{
+			Name:            member.Name,
+			Type:            member.Type,
+			Required:        member.Required,
+			Optional:        !member.Required,
+			Computed:        !member.Required && r.Shape.Member(member.Name) != nil,
+			RequiresReplace: !updatable(member.Name),
+		}
+		attribute.Attributes = s.nestedAttributes(member.Type, false, map[string]bool{})
+		attributes = append(attributes, attribute)
+	}
+
+	if r.Shape != nil {
+		for _, member := range r.Shape.Members {
+			if skippedMembers[member.Name] || seen[member.Name] {
+				continue
+			}
+
+			attribute := &Attribute{
+				Name:               member.Name,
+				Type:               member.Type,
+				Computed:           true,
+				UseStateForUnknown: r.Update == "" || !volatile(member.Name),
+			}
+			attribute.Attributes = s.nestedAttributes(member.Type, true, map[string]bool{})
+			attributes = append(attributes, attribute)
+		}
+	}
+
+	return attributes
+}
+
+func (s *Service) nestedAttributes(t *Type, computed bool, seen map[string]bool) []*Attribute {
+	if t.Kind == KindList {
+		t = t.Elem
+	}
+
+	if t.Kind != KindStructure || seen[t.Name] {
+		return nil
+	}
+
+	// Recursive structures are truncated.
+	seen[t.Name] = true
+	defer delete(seen, t.Name)
+
+	var attributes []*Attribute
+
+	for _, member := range s.Shape(t.Name).Members {
+		attribute := &Attribute{
+			Name:     member.Name,
+			Type:     member.Type,
+			Required: !computed && member.Required,
+			Optional: !computed && !member.Required,
+			Computed: computed,
+		}
+		attribute.Attributes = s.nestedAttributes(member.Type, computed, seen)
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes
+}
+
+func (s *Service) firstOperation(names ...string) string {
+	for _, name := range names {
+		if s.HasOperation(name) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// identifierMember returns the first required string member of an operation's input.
+func identifierMember(input *Shape) string {
+	if input == nil {
+		return ""
+	}
+
+	for _, member := range input.Members {
+		if member.Required && member.Type.Kind == KindString {
+			return member.Name
+		}
+	}
+
+	return ""
+}
+
+func matchStatuses(values []EnumValue, statuses []string) []EnumValue {
+	var matches []EnumValue
+
+	for _, value := range values {
+		normalized := strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToUpper(value.Value))
+		for _, status := range statuses {
+			if normalized == status {
+				matches = append(matches, value)
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+func plurals(s string) []string {
+	v := []string{s + "s", s + "es"}
+
+	if strings.HasSuffix(s, "y") {
+		v = append(v, strings.TrimSuffix(s, "y")+"ies")
+	}
+
+	return v
+}
+
+// volatile returns whether a computed member's value may change after the resource has been created.
+func volatile(name string) bool {
+	for _, v := range []string{"Status", "Updated", "Modified", "State"} {
+		if strings.Contains(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
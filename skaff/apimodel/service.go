@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apimodel reads the API model of an AWS service from the AWS SDK for Go v2 source.
+package apimodel
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	typesPackageName = "types"
+)
+
+// Service is the API model of an AWS service.
+type Service struct {
+	ImportPath  string // e.g. "github.com/aws/aws-sdk-go-v2/service/example"
+	PackageName string // e.g. "example"
+	ServiceID   string // e.g. "Example Service"
+
+	enums      map[string][]EnumValue // Keyed by type name.
+	operations map[string]bool        // Client methods.
+	paginators map[string]bool        // Keyed by operation name.
+	shapes     map[string]*Shape      // Keyed by qualified type name, e.g. "CreateWidgetInput" or "types.Widget".
+}
+
+// Shape is an API structure, e.g. an operation's input or output or a structure in the service's types package.
+type Shape struct {
+	Name    string // Qualified type name, e.g. "CreateWidgetInput" or "types.Widget".
+	Members []*Member
+}
+
+// Member is a member of an API structure.
+type Member struct {
+	Name     string
+	Required bool
+	Type     *Type
+}
+
+// Kind is the kind of an API type.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindBool
+	KindEnum
+	KindFloat
+	KindInteger
+	KindList
+	KindMap
+	KindString
+	KindStructure
+	KindTimestamp
+)
+
+// Type is the type of an API structure member.
+type Type struct {
+	Kind    Kind
+	Name    string // For enumerations and structures, the qualified type name, e.g. "types.WidgetStatus".
+	Pointer bool
+	Elem    *Type // For lists and maps.
+	GoType  string
+}
+
+// EnumValue is a value of an API enumeration.
+type EnumValue struct {
+	ConstName string // e.g. "WidgetStatusActive"
+	Value     string // e.g. "ACTIVE"
+}
+
+// LoadService loads the API model of the AWS service whose AWS SDK for Go v2 package has the specified import path.
+// The package's source directory is resolved using the Go module of the current working directory.
+func LoadService(importPath string) (*Service, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}", importPath)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("locating %s source: %w: %s", importPath, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return ParseService(importPath, strings.TrimSpace(stdout.String()))
+}
+
+// ParseService parses the API model of the AWS service whose AWS SDK for Go v2 package source is in the specified directory.
+func ParseService(importPath, dir string) (*Service, error) {
+	s := &Service{
+		ImportPath:  importPath,
+		PackageName: importPath[strings.LastIndex(importPath, "/")+1:],
+		enums:       make(map[string][]EnumValue),
+		operations:  make(map[string]bool),
+		paginators:  make(map[string]bool),
+		shapes:      make(map[string]*Shape),
+	}
+
+	files, err := parseDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		s.addDecls(file, "")
+	}
+
+	files, err = parseDir(filepath.Join(dir, typesPackageName))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		s.addDecls(file, typesPackageName)
+	}
+
+	for _, shape := range s.shapes {
+		for _, member := range shape.Members {
+			s.resolve(member.Type)
+		}
+	}
+
+	return s, nil
+}
+
+// HasOperation returns whether the service has the named operation.
+func (s *Service) HasOperation(name string) bool {
+	return s.operations[name]
+}
+
+// HasPaginator returns whether the named operation has a paginator.
+func (s *Service) HasPaginator(name string) bool {
+	return s.paginators[name]
+}
+
+// Paginators returns the names of the operations that have paginators, sorted.
+func (s *Service) Paginators() []string {
+	names := make([]string, 0, len(s.paginators))
+	for name := range s.paginators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Shape returns the API structure with the specified qualified type name.
+func (s *Service) Shape(name string) *Shape {
+	return s.shapes[name]
+}
+
+// EnumValues returns the values of the API enumeration with the specified qualified type name.
+func (s *Service) EnumValues(name string) []EnumValue {
+	return s.enums[strings.TrimPrefix(name, typesPackageName+".")]
+}
+
+// Member returns the named member of the API structure.
+func (shape *Shape) Member(name string) *Member {
+	if shape == nil {
+		return nil
+	}
+
+	for _, member := range shape.Members {
+		if member.Name == name {
+			return member
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) addDecls(file *ast.File, pkg string) {
+	qualify := func(name string) string {
+		if pkg == "" {
+			return name
+		}
+		return pkg + "." + name
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if pkg != "" || !decl.Name.IsExported() {
+				continue
+			}
+
+			if decl.Recv != nil {
+				if star, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+					if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "Client" {
+						s.operations[decl.Name.Name] = true
+					}
+				}
+			} else if name := decl.Name.Name; strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Paginator") {
+				s.paginators[strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Paginator")] = true
+			}
+
+		case *ast.GenDecl:
+			switch decl.Tok {
+			case token.TYPE:
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.TypeSpec)
+
+					switch t := spec.Type.(type) {
+					case *ast.StructType:
+						s.shapes[qualify(spec.Name.Name)] = &Shape{
+							Name:    qualify(spec.Name.Name),
+							Members: members(t, pkg),
+						}
+
+					case *ast.Ident:
+						if pkg != "" && t.Name == "string" {
+							if _, ok := s.enums[spec.Name.Name]; !ok {
+								s.enums[spec.Name.Name] = nil
+							}
+						}
+					}
+				}
+
+			case token.CONST:
+				if pkg == "" {
+					if v, ok := constValue(decl, "ServiceID"); ok {
+						s.ServiceID = v
+					}
+					continue
+				}
+
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.ValueSpec)
+
+					ident, ok := spec.Type.(*ast.Ident)
+					if !ok {
+						continue
+					}
+
+					for i, name := range spec.Names {
+						if i >= len(spec.Values) {
+							break
+						}
+						lit, ok := spec.Values[i].(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING {
+							continue
+						}
+						value, err := strconv.Unquote(lit.Value)
+						if err != nil {
+							continue
+						}
+
+						s.enums[ident.Name] = append(s.enums[ident.Name], EnumValue{
+							ConstName: name.Name,
+							Value:     value,
+						})
+					}
+				}
+			}
+		}
+	}
+}
+
+// constValue returns the value of the named untyped string constant in the declaration.
+func constValue(decl *ast.GenDecl, name string) (string, bool) {
+	for _, spec := range decl.Specs {
+		spec := spec.(*ast.ValueSpec)
+
+		for i, ident := range spec.Names {
+			if ident.Name != name || i >= len(spec.Values) {
+				continue
+			}
+
+			lit, ok := spec.Values[i].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return "", false
+			}
+
+			value, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return "", false
+			}
+
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// resolve completes the kinds of any enumeration and structure types once all declarations have been read.
+func (s *Service) resolve(t *Type) {
+	if t == nil {
+		return
+	}
+
+	if t.Kind == KindStructure {
+		if _, ok := s.shapes[t.Name]; !ok {
+			if _, ok := s.enums[strings.TrimPrefix(t.Name, typesPackageName+".")]; ok {
+				t.Kind = KindEnum
+			} else {
+				// e.g. a union interface.
+				t.Kind = KindUnsupported
+			}
+		}
+	}
+
+	s.resolve(t.Elem)
+}
+
+func members(t *ast.StructType, pkg string) []*Member {
+	var members []*Member
+
+	for _, field := range t.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			members = append(members, &Member{
+				Name:     name.Name,
+				Required: field.Doc != nil && strings.Contains(field.Doc.Text(), "This member is required."),
+				Type:     typeOf(field.Type, pkg),
+			})
+		}
+	}
+
+	return members
+}
+
+func typeOf(expr ast.Expr, pkg string) *Type {
+	t := &Type{
+		GoType: exprString(expr, pkg),
+	}
+
+	if star, ok := expr.(*ast.StarExpr); ok {
+		t.Pointer = true
+		expr = star.X
+	}
+
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		switch expr.Name {
+		case "bool":
+			t.Kind = KindBool
+		case "float32", "float64":
+			t.Kind = KindFloat
+		case "int32", "int64":
+			t.Kind = KindInteger
+		case "string":
+			t.Kind = KindString
+		default:
+			if expr.IsExported() {
+				// Resolved later to a structure or enumeration.
+				t.Kind = KindStructure
+				t.Name = typesPackageName + "." + expr.Name
+			}
+		}
+
+	case *ast.SelectorExpr:
+		if x, ok := expr.X.(*ast.Ident); ok {
+			switch x.Name {
+			case "time":
+				if expr.Sel.Name == "Time" {
+					t.Kind = KindTimestamp
+				}
+			case typesPackageName:
+				t.Kind = KindStructure
+				t.Name = typesPackageName + "." + expr.Sel.Name
+			}
+		}
+
+	case *ast.ArrayType:
+		if ident, ok := expr.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			break
+		}
+		t.Kind = KindList
+		t.Elem = typeOf(expr.Elt, pkg)
+
+	case *ast.MapType:
+		if ident, ok := expr.Key.(*ast.Ident); ok && ident.Name == "string" {
+			t.Kind = KindMap
+			t.Elem = typeOf(expr.Value, pkg)
+		}
+	}
+
+	return t
+}
+
+// exprString returns the Go type expression as seen from outside the types package, e.g. "*awstypes.Widget".
+func exprString(expr ast.Expr, pkg string) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if pkg != "" && expr.IsExported() {
+			return "awstypes." + expr.Name
+		}
+		return expr.Name
+	case *ast.StarExpr:
+		return "*" + exprString(expr.X, pkg)
+	case *ast.SelectorExpr:
+		if x, ok := expr.X.(*ast.Ident); ok {
+			if x.Name == typesPackageName {
+				return "awstypes." + expr.Sel.Name
+			}
+			return x.Name + "." + expr.Sel.Name
+		}
+	case *ast.ArrayType:
+		return "[]" + exprString(expr.Elt, pkg)
+	case *ast.MapType:
+		return "map[" + exprString(expr.Key, pkg) + "]" + exprString(expr.Value, pkg)
+	}
+
+	return "any"
+}
+
+func parseDir(dir string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading directory %s: %w", dir, err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if name := entry.Name(); !entry.IsDir() && strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		filename := filepath.Join(dir, name)
+		file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
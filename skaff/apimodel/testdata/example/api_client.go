@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package example
+
+const ServiceID = "Example Service"
+
+type Client struct {
+	options Options
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package example
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/example/types"
+)
+
+func (c *Client) CreateWidget(ctx context.Context, params *CreateWidgetInput, optFns ...func(*Options)) (*CreateWidgetOutput, error) {
+	return nil, nil
+}
+
+type CreateWidgetInput struct {
+
+	// The widget's name.
+	//
+	// This member is required.
+	Name *string
+
+	ClientToken *string
+
+	Description *string
+
+	Configuration *types.WidgetConfiguration
+
+	Tags map[string]string
+
+	noSmithyDocumentSerde
+}
+
+type CreateWidgetOutput struct {
+	Widget *types.Widget
+
+	ResultMetadata middleware.Metadata
+
+	noSmithyDocumentSerde
+}
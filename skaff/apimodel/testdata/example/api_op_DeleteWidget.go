@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package example
+
+func (c *Client) DeleteWidget(ctx context.Context, params *DeleteWidgetInput, optFns ...func(*Options)) (*DeleteWidgetOutput, error) {
+	return nil, nil
+}
+
+type DeleteWidgetInput struct {
+
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type DeleteWidgetOutput struct {
+	noSmithyDocumentSerde
+}
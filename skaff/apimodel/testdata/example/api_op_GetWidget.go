@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package example
+
+func (c *Client) GetWidget(ctx context.Context, params *GetWidgetInput, optFns ...func(*Options)) (*GetWidgetOutput, error) {
+	return nil, nil
+}
+
+type GetWidgetInput struct {
+
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetOutput struct {
+
+	// This member is required.
+	Widget *types.Widget
+
+	noSmithyDocumentSerde
+}
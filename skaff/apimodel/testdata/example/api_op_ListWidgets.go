@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package example
+
+func (c *Client) ListWidgets(ctx context.Context, params *ListWidgetsInput, optFns ...func(*Options)) (*ListWidgetsOutput, error) {
+	return nil, nil
+}
+
+type ListWidgetsInput struct {
+	MaxResults *int32
+
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsOutput struct {
+	NextToken *string
+
+	Widgets []types.WidgetSummary
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsPaginator struct{}
+
+func NewListWidgetsPaginator(client ListWidgetsAPIClient, params *ListWidgetsInput, optFns ...func(*ListWidgetsPaginatorOptions)) *ListWidgetsPaginator {
+	return nil
+}
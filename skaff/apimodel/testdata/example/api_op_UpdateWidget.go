@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package example
+
+func (c *Client) UpdateWidget(ctx context.Context, params *UpdateWidgetInput, optFns ...func(*Options)) (*UpdateWidgetOutput, error) {
+	return nil, nil
+}
+
+type UpdateWidgetInput struct {
+
+	// This member is required.
+	WidgetIdentifier *string
+
+	Description *string
+
+	noSmithyDocumentSerde
+}
+
+type UpdateWidgetOutput struct {
+	noSmithyDocumentSerde
+}
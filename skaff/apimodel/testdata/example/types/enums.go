@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+type WidgetStatus string
+
+// Enum values for WidgetStatus
+const (
+	WidgetStatusCreating     WidgetStatus = "CREATING"
+	WidgetStatusActive       WidgetStatus = "ACTIVE"
+	WidgetStatusUpdating     WidgetStatus = "UPDATING"
+	WidgetStatusDeleting     WidgetStatus = "DELETING"
+	WidgetStatusCreateFailed WidgetStatus = "CREATE_FAILED"
+)
+
+func (WidgetStatus) Values() []WidgetStatus {
+	return []WidgetStatus{
+		"CREATING",
+		"ACTIVE",
+		"UPDATING",
+		"DELETING",
+		"CREATE_FAILED",
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"time"
+)
+
+type Widget struct {
+
+	// This member is required.
+	Arn *string
+
+	// This member is required.
+	Id *string
+
+	// This member is required.
+	Name *string
+
+	Configuration *WidgetConfiguration
+
+	CreatedAt *time.Time
+
+	Description *string
+
+	Status WidgetStatus
+
+	noSmithyDocumentSerde
+}
+
+type WidgetConfiguration struct {
+
+	// This member is required.
+	Size *int32
+
+	Labels []string
+
+	Parts []WidgetPart
+
+	Unknown Union
+
+	noSmithyDocumentSerde
+}
+
+type WidgetPart struct {
+	Enabled *bool
+
+	Parent *WidgetPart
+
+	noSmithyDocumentSerde
+}
+
+type WidgetSummary struct {
+	Arn *string
+
+	Id *string
+
+	noSmithyDocumentSerde
+}
+
+type Union interface {
+	isUnion()
+}
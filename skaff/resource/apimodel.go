@@ -0,0 +1,653 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+const (
+	attrConstantsFile = "../../../names/attr_constants.csv"
+)
+
+// APIResourceData is the template data for a resource generated from the AWS SDK for Go v2 API model.
+type APIResourceData struct {
+	GoV2Package string // e.g. "example"
+	ImportPath  string // e.g. "github.com/aws/aws-sdk-go-v2/service/example"
+
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+
+	FinderResultType      string // e.g. "*awstypes.Widget"
+	ReadOutputMember      string
+	ReadInputIdentifier   string
+	UpdateInputIdentifier string
+	DeleteInputIdentifier string
+	// CreateOutputIdentifier is the expression for the new resource's identifier, e.g. "output.Widget.WidgetId".
+	CreateOutputIdentifier string
+	NotFoundException      string
+
+	ListOutputMember string
+	ListIdentifier   string
+
+	ClientToken             bool
+	Tags                    bool
+	TagsIdentifierAttribute string
+
+	// Model fields compared to decide whether the update operation is called.
+	UpdatableFields []string
+	// RefreshAfterUpdate is set if the resource has computed values that may change on update.
+	RefreshAfterUpdate bool
+
+	Status         *APIResourceStatus
+	CreateWaiter   bool
+	UpdateWaiter   bool
+	DeleteWaiter   bool
+	Timeouts       bool
+	TimeoutsCreate bool
+	TimeoutsUpdate bool
+	TimeoutsDelete bool
+
+	Imports     []string
+	TestImports []string
+
+	SchemaAttributes string
+	SchemaBlocks     string
+	ModelFields      string
+	NestedModels     string
+
+	// Acceptance test data.
+	TestCheckAttributesSet []string
+	TestConfigArguments    []string
+	TestConfigTODOs        []string
+}
+
+// APIResourceStatus is the template data for a resource's status and waiters.
+type APIResourceStatus struct {
+	Member        string
+	CreatePending []string
+	Target        []string
+	UpdatePending []string
+	DeletePending []string
+}
+
+// newAPIResourceData derives template data from the AWS SDK for Go v2 API model of the resource created by the specified operation.
+func newAPIResourceData(s *apimodel.Service, createOperation, resName, snakeName string) (*APIResourceData, error) {
+	r, err := s.Resource(createOperation)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Read == "" {
+		return nil, fmt.Errorf("%s has no Get%[2]s or Describe%[2]s operation", s.ImportPath, r.Name)
+	}
+	if r.Delete == "" {
+		return nil, fmt.Errorf("%s has no Delete%s operation", s.ImportPath, r.Name)
+	}
+	if r.Identifier == "" || r.ReadInputIdentifier == "" || r.DeleteInputIdentifier == "" {
+		return nil, fmt.Errorf("unable to determine %s identifier", r.Name)
+	}
+
+	data := &APIResourceData{
+		GoV2Package:           s.PackageName,
+		ImportPath:            s.ImportPath,
+		Create:                r.Create,
+		Read:                  r.Read,
+		Update:                r.Update,
+		Delete:                r.Delete,
+		List:                  r.List,
+		ReadOutputMember:      r.ReadOutputMember,
+		ReadInputIdentifier:   r.ReadInputIdentifier,
+		UpdateInputIdentifier: r.UpdateInputIdentifier,
+		DeleteInputIdentifier: r.DeleteInputIdentifier,
+		ListOutputMember:      r.ListOutputMember,
+		ListIdentifier:        r.ListIdentifier,
+		ClientToken:           r.ClientToken,
+		Tags:                  r.Tags,
+		NotFoundException:     "ResourceNotFoundException",
+	}
+
+	if data.ReadOutputMember != "" {
+		data.FinderResultType = "*awstypes." + strings.TrimPrefix(r.Shape.Name, "types.")
+	} else {
+		data.FinderResultType = fmt.Sprintf("*%s.%s", s.PackageName, r.Shape.Name)
+	}
+
+	if len(r.CreateOutputIdentifier) > 0 {
+		data.CreateOutputIdentifier = "output." + strings.Join(r.CreateOutputIdentifier, ".")
+	}
+
+	for _, v := range []string{"ResourceNotFoundException", "NotFoundException", r.Name + "NotFoundException"} {
+		if s.Shape("types."+v) != nil {
+			data.NotFoundException = v
+			break
+		}
+	}
+
+	if r.Status != nil && len(r.Status.Target) > 0 {
+		constNames := func(values []apimodel.EnumValue) []string {
+			var v []string
+			for _, value := range values {
+				v = append(v, "awstypes."+value.ConstName)
+			}
+			return v
+		}
+
+		data.Status = &APIResourceStatus{
+			Member:        r.Status.Member,
+			CreatePending: constNames(r.Status.CreatePending),
+			Target:        constNames(r.Status.Target),
+			UpdatePending: constNames(r.Status.UpdatePending),
+			DeletePending: constNames(r.Status.DeletePending),
+		}
+		if len(data.Status.DeletePending) == 0 {
+			data.Status.DeletePending = data.Status.Target
+		}
+
+		data.CreateWaiter = true
+		data.UpdateWaiter = r.Update != "" && len(r.Status.UpdatePending) > 0
+		data.DeleteWaiter = true
+	}
+
+	data.TimeoutsCreate = data.CreateWaiter
+	data.TimeoutsUpdate = data.UpdateWaiter
+	data.TimeoutsDelete = data.DeleteWaiter
+	data.Timeouts = data.TimeoutsCreate || data.TimeoutsUpdate || data.TimeoutsDelete
+
+	g := &codeGenerator{
+		attrConsts: readAttrConstants(attrConstantsFile),
+		imports:    make(map[string]string),
+		models:     make(map[string]bool),
+	}
+
+	g.generate(r, data, resName, snakeName)
+
+	return data, nil
+}
+
+type codeGenerator struct {
+	attrConsts map[string]string // snake case name -> names.AttrXxx constant.
+	imports    map[string]string // import path -> alias.
+	models     map[string]bool   // Emitted nested models.
+
+	nestedModels []string // In order of first use.
+}
+
+type schemaEntry struct {
+	name string // Terraform attribute name, used for ordering.
+	code string
+}
+
+func (g *codeGenerator) generate(r *apimodel.Resource, data *APIResourceData, resName, snakeName string) {
+	var attributes, blocks []schemaEntry
+	var fields []schemaEntry
+
+	idAutoFlex := ""
+	if r.Identifier != "Id" && r.Shape.Member("Id") != nil {
+		// The API's "Id" member isn't the resource's identifier.
+		idAutoFlex = ` autoflex:"-"`
+	}
+
+	attributes = append(attributes, schemaEntry{"id", "names.AttrID: framework.IDAttribute(),\n"})
+	fields = append(fields, schemaEntry{"id", fmt.Sprintf("ID types.String `tfsdk:\"id\"%s`\n", idAutoFlex)})
+
+	if r.Tags {
+		g.addImport("github.com/hashicorp/terraform-provider-aws/internal/tags", "tftags")
+		attributes = append(attributes,
+			schemaEntry{"tags", "names.AttrTags: tftags.TagsAttribute(),\n"},
+			schemaEntry{"tags_all", "names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),\n"},
+		)
+		fields = append(fields,
+			schemaEntry{"tags", "Tags types.Map `tfsdk:\"tags\"`\n"},
+			schemaEntry{"tags_all", "TagsAll types.Map `tfsdk:\"tags_all\"`\n"},
+		)
+		data.TagsIdentifierAttribute = "id"
+	}
+
+	if data.Timeouts {
+		g.addImport("github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts", "")
+		blocks = append(blocks, schemaEntry{"timeouts", fmt.Sprintf("names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{\n%s}),\n", timeoutsOpts(data))})
+		fields = append(fields, schemaEntry{"timeouts", "Timeouts timeouts.Value `tfsdk:\"timeouts\"`\n"})
+	}
+
+	for _, attribute := range r.Attributes {
+		name, field, autoFlex := convert.ToSnakeCase(attribute.Name, ""), fieldName(attribute.Name), ""
+
+		switch {
+		case attribute.Name == "Id" && r.Identifier == "Id":
+			// Mapped to "id".
+			continue
+		case attribute.Name == "Id":
+			name, field, autoFlex = snakeName+"_id", resName+"ID", ` autoflex:"Id"`
+		case attribute.Name == "Arn" && attribute.Computed && !attribute.Optional && attribute.Type.Kind == apimodel.KindString:
+			attributes = append(attributes, schemaEntry{"arn", "names.AttrARN: framework.ARNAttributeComputedOnly(),\n"})
+			fields = append(fields, schemaEntry{"arn", "ARN types.String `tfsdk:\"arn\"`\n"})
+			data.TestCheckAttributesSet = append(data.TestCheckAttributesSet, "names.AttrARN")
+			continue
+		}
+
+		code, modelType, isBlock := g.schema(attribute, name, true)
+
+		if isBlock {
+			blocks = append(blocks, schemaEntry{name, code})
+		} else {
+			attributes = append(attributes, schemaEntry{name, code})
+		}
+
+		if modelType == "" {
+			fields = append(fields, schemaEntry{name, fmt.Sprintf("// TODO %s %s `tfsdk:%q`\n", field, attribute.Type.GoType, name)})
+			continue
+		}
+
+		fields = append(fields, schemaEntry{name, fmt.Sprintf("%s %s `tfsdk:%q%s`\n", field, modelType, name, autoFlex)})
+
+		if attribute.Optional || attribute.Required {
+			if !attribute.RequiresReplace {
+				data.UpdatableFields = append(data.UpdatableFields, field)
+			}
+
+			switch {
+			case attribute.Required && attribute.Type.Kind == apimodel.KindString && name == "name":
+				data.TestConfigArguments = append(data.TestConfigArguments, fmt.Sprintf("%s = %%[1]q", name))
+			case attribute.Required:
+				data.TestConfigTODOs = append(data.TestConfigTODOs, name)
+			}
+		} else {
+			if !attribute.UseStateForUnknown {
+				data.RefreshAfterUpdate = true
+			}
+
+			if attribute.Type.Kind != apimodel.KindStructure && attribute.Type.Kind != apimodel.KindList && attribute.Type.Kind != apimodel.KindMap {
+				data.TestCheckAttributesSet = append(data.TestCheckAttributesSet, g.attrName(name))
+			}
+		}
+	}
+
+	if r.Tags && !strings.HasSuffix(r.Identifier, "Arn") {
+		// Resources are tagged by ARN.
+		for _, v := range []string{"Arn", r.Name + "Arn"} {
+			if r.Shape.Member(v) != nil {
+				data.TagsIdentifierAttribute = convert.ToSnakeCase(v, "")
+				break
+			}
+		}
+	}
+
+	data.TestCheckAttributesSet = append(data.TestCheckAttributesSet, "names.AttrID")
+	sort.Slice(data.TestCheckAttributesSet, func(i, j int) bool {
+		key := func(v string) string {
+			return strings.ToLower(strings.Trim(strings.TrimPrefix(v, "names.Attr"), `"_`))
+		}
+		return key(data.TestCheckAttributesSet[i]) < key(data.TestCheckAttributesSet[j])
+	})
+
+	data.SchemaAttributes = g.entries(attributes)
+	data.SchemaBlocks = g.entries(blocks)
+	data.ModelFields = g.entries(fields)
+	data.NestedModels = strings.Join(g.nestedModels, "")
+	data.Imports = g.importSpecs(data)
+	data.TestImports = testImportSpecs(data)
+}
+
+// schema returns the schema definition and model type of an attribute.
+// An empty model type is returned for unsupported types.
+func (g *codeGenerator) schema(attribute *apimodel.Attribute, name string, topLevel bool) (string, string, bool) {
+	var sb strings.Builder
+	t := attribute.Type
+
+	fmt.Fprintf(&sb, "%s: ", g.attrName(name))
+
+	var schemaType, modelType, customType, planModifierType, elementType string
+
+	switch t.Kind {
+	case apimodel.KindString:
+		schemaType, modelType, planModifierType = "StringAttribute", "types.String", "String"
+		if strings.HasSuffix(attribute.Name, "Arn") && !attribute.Computed {
+			modelType, customType = "fwtypes.ARN", "fwtypes.ARNType"
+		}
+	case apimodel.KindEnum:
+		enumType := "awstypes." + strings.TrimPrefix(t.Name, "types.")
+		schemaType, planModifierType = "StringAttribute", "String"
+		modelType, customType = fmt.Sprintf("fwtypes.StringEnum[%s]", enumType), fmt.Sprintf("fwtypes.StringEnumType[%s]()", enumType)
+	case apimodel.KindBool:
+		schemaType, modelType, planModifierType = "BoolAttribute", "types.Bool", "Bool"
+	case apimodel.KindInteger:
+		schemaType, modelType, planModifierType = "Int64Attribute", "types.Int64", "Int64"
+	case apimodel.KindFloat:
+		schemaType, modelType, planModifierType = "Float64Attribute", "types.Float64", "Float64"
+	case apimodel.KindTimestamp:
+		g.addImport("github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes", "")
+		schemaType, modelType, customType, planModifierType = "StringAttribute", "timetypes.RFC3339", "timetypes.RFC3339Type{}", "String"
+	case apimodel.KindMap:
+		if t.Elem.Kind == apimodel.KindString {
+			schemaType, modelType, customType, planModifierType = "MapAttribute", "fwtypes.MapValueOf[types.String]", "fwtypes.MapOfStringType", "Map"
+			elementType = "types.StringType"
+		}
+	case apimodel.KindList, apimodel.KindStructure:
+		elem := t
+		if t.Kind == apimodel.KindList {
+			elem = t.Elem
+		}
+
+		switch elem.Kind {
+		case apimodel.KindString, apimodel.KindEnum:
+			schemaType, modelType, customType, planModifierType = "ListAttribute", "fwtypes.ListValueOf[types.String]", "fwtypes.ListOfStringType", "List"
+			elementType = "types.StringType"
+		case apimodel.KindStructure:
+			if attribute.Attributes == nil {
+				// Recursive structure.
+				break
+			}
+
+			model := g.nestedModel(elem.Name, attribute.Attributes)
+			modelType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", model)
+			customType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", model)
+			planModifierType = "List"
+
+			if attribute.Computed && !attribute.Optional {
+				schemaType, elementType = "ListAttribute", fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", model)
+				break
+			}
+
+			g.addImport("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+			g.addImport("github.com/hashicorp/terraform-plugin-framework-validators/listvalidator", "")
+			g.addImport("github.com/hashicorp/terraform-plugin-framework/schema/validator", "")
+
+			fmt.Fprintf(&sb, "schema.ListNestedBlock{\n")
+			fmt.Fprintf(&sb, "CustomType: %s,\n", customType)
+			if attribute.Required || t.Kind == apimodel.KindStructure {
+				fmt.Fprintf(&sb, "Validators: []validator.List{\n")
+				if attribute.Required {
+					fmt.Fprintf(&sb, "listvalidator.IsRequired(),\n")
+				}
+				if t.Kind == apimodel.KindStructure {
+					fmt.Fprintf(&sb, "listvalidator.SizeAtMost(1),\n")
+				}
+				fmt.Fprintf(&sb, "},\n")
+			}
+			if topLevel && attribute.RequiresReplace {
+				g.planModifiers(&sb, planModifierType, true, false)
+			}
+			fmt.Fprintf(&sb, "NestedObject: schema.NestedBlockObject{\n")
+			g.nestedSchema(&sb, attribute.Attributes)
+			fmt.Fprintf(&sb, "},\n")
+			fmt.Fprintf(&sb, "},\n")
+
+			return sb.String(), modelType, true
+		}
+	}
+
+	if schemaType == "" {
+		return fmt.Sprintf("// TODO %s (%s) is not supported.\n", g.attrName(name), t.GoType), "", false
+	}
+
+	if strings.Contains(customType, "fwtypes.") {
+		g.addImport("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+	}
+
+	fmt.Fprintf(&sb, "schema.%s{\n", schemaType)
+	if customType != "" {
+		fmt.Fprintf(&sb, "CustomType: %s,\n", customType)
+	}
+	switch {
+	case attribute.Required:
+		fmt.Fprintf(&sb, "Required: true,\n")
+	case attribute.Optional && attribute.Computed:
+		fmt.Fprintf(&sb, "Optional: true,\n")
+		fmt.Fprintf(&sb, "Computed: true,\n")
+	case attribute.Optional:
+		fmt.Fprintf(&sb, "Optional: true,\n")
+	default:
+		fmt.Fprintf(&sb, "Computed: true,\n")
+	}
+	if elementType != "" {
+		fmt.Fprintf(&sb, "ElementType: %s,\n", elementType)
+	}
+	if topLevel {
+		g.planModifiers(&sb, planModifierType, attribute.RequiresReplace, attribute.UseStateForUnknown || (attribute.Optional && attribute.Computed))
+	}
+	fmt.Fprintf(&sb, "},\n")
+
+	return sb.String(), modelType, false
+}
+
+func (g *codeGenerator) nestedSchema(sb *strings.Builder, attributes []*apimodel.Attribute) {
+	var nestedAttributes, nestedBlocks []schemaEntry
+
+	for _, attribute := range attributes {
+		name := convert.ToSnakeCase(attribute.Name, "")
+		code, _, isBlock := g.schema(attribute, name, false)
+
+		if isBlock {
+			nestedBlocks = append(nestedBlocks, schemaEntry{name, code})
+		} else {
+			nestedAttributes = append(nestedAttributes, schemaEntry{name, code})
+		}
+	}
+
+	if len(nestedAttributes) > 0 {
+		fmt.Fprintf(sb, "Attributes: map[string]schema.Attribute{\n%s},\n", g.entries(nestedAttributes))
+	}
+	if len(nestedBlocks) > 0 {
+		fmt.Fprintf(sb, "Blocks: map[string]schema.Block{\n%s},\n", g.entries(nestedBlocks))
+	}
+}
+
+// nestedModel emits the model for a nested structure, returning the model's type name.
+func (g *codeGenerator) nestedModel(shapeName string, attributes []*apimodel.Attribute) string {
+	model := convert.ToLowercasePrefix(strings.TrimPrefix(shapeName, "types.")) + "Model"
+
+	if g.models[model] {
+		return model
+	}
+	g.models[model] = true
+
+	i := len(g.nestedModels)
+	g.nestedModels = append(g.nestedModels, "")
+
+	var fields []schemaEntry
+
+	for _, attribute := range attributes {
+		name := convert.ToSnakeCase(attribute.Name, "")
+		_, modelType, _ := g.schema(attribute, name, false)
+		field := fieldName(attribute.Name)
+
+		if modelType == "" {
+			fields = append(fields, schemaEntry{name, fmt.Sprintf("// TODO %s %s `tfsdk:%q`\n", field, attribute.Type.GoType, name)})
+			continue
+		}
+
+		fields = append(fields, schemaEntry{name, fmt.Sprintf("%s %s `tfsdk:%q`\n", field, modelType, name)})
+	}
+
+	g.nestedModels[i] = fmt.Sprintf("\ntype %s struct {\n%s}\n", model, g.entries(fields))
+
+	return model
+}
+
+func (g *codeGenerator) planModifiers(sb *strings.Builder, planModifierType string, requiresReplace, useStateForUnknown bool) {
+	if !requiresReplace && !useStateForUnknown {
+		return
+	}
+
+	pkg := strings.ToLower(planModifierType) + "planmodifier"
+	g.addImport("github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier", "")
+	g.addImport("github.com/hashicorp/terraform-plugin-framework/resource/schema/"+pkg, "")
+
+	fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n", planModifierType)
+	if requiresReplace {
+		fmt.Fprintf(sb, "%s.RequiresReplace(),\n", pkg)
+	}
+	if useStateForUnknown {
+		fmt.Fprintf(sb, "%s.UseStateForUnknown(),\n", pkg)
+	}
+	fmt.Fprintf(sb, "},\n")
+}
+
+// attrName returns the Go expression for a Terraform attribute name, preferring the names package's constants.
+func (g *codeGenerator) attrName(name string) string {
+	if v, ok := g.attrConsts[name]; ok {
+		return v
+	}
+
+	return strconv.Quote(name)
+}
+
+func (g *codeGenerator) entries(entries []schemaEntry) string {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	var sb strings.Builder
+	for _, entry := range entries {
+		sb.WriteString(entry.code)
+	}
+
+	return sb.String()
+}
+
+func (g *codeGenerator) addImport(path, alias string) {
+	g.imports[path] = alias
+}
+
+// importSpecs returns the resource source file's import specs, standard library packages first.
+func (g *codeGenerator) importSpecs(data *APIResourceData) []string {
+	std := []string{"context", "fmt"}
+	if data.Timeouts {
+		std = append(std, "time")
+	}
+
+	g.addImport("github.com/aws/aws-sdk-go-v2/aws", "")
+	g.addImport(data.ImportPath, "")
+	g.addImport(data.ImportPath+"/types", "awstypes")
+	g.addImport("github.com/hashicorp/terraform-plugin-framework/resource", "")
+	g.addImport("github.com/hashicorp/terraform-plugin-framework/resource/schema", "")
+	g.addImport("github.com/hashicorp/terraform-plugin-framework/types", "")
+	g.addImport("github.com/hashicorp/terraform-provider-aws/internal/errs", "")
+	g.addImport("github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag", "")
+	g.addImport("github.com/hashicorp/terraform-provider-aws/internal/framework", "")
+	g.addImport("github.com/hashicorp/terraform-provider-aws/internal/framework/flex", "fwflex")
+	g.addImport("github.com/hashicorp/terraform-provider-aws/internal/retry", "")
+	g.addImport("github.com/hashicorp/terraform-provider-aws/internal/tfresource", "")
+	g.addImport("github.com/hashicorp/terraform-provider-aws/names", "")
+	if data.ClientToken {
+		g.addImport("github.com/hashicorp/go-uuid", "uuid")
+	}
+	if data.Status != nil {
+		g.addImport("github.com/hashicorp/terraform-provider-aws/internal/enum", "")
+	}
+	if data.CreateWaiter {
+		g.addImport("github.com/hashicorp/terraform-plugin-framework/path", "")
+	}
+
+	specs := make([]string, 0, len(std)+1+len(g.imports))
+	for _, path := range std {
+		specs = append(specs, strconv.Quote(path))
+	}
+	specs = append(specs, "")
+
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		specs = append(specs, strings.TrimSpace(g.imports[path]+" "+strconv.Quote(path)))
+	}
+
+	return specs
+}
+
+func testImportSpecs(data *APIResourceData) []string {
+	specs := []string{
+		`"context"`,
+		`"fmt"`,
+		`"testing"`,
+		"",
+	}
+
+	if strings.HasPrefix(data.FinderResultType, "*awstypes.") {
+		specs = append(specs, fmt.Sprintf("awstypes %q", data.ImportPath+"/types"))
+	} else {
+		specs = append(specs, strconv.Quote(data.ImportPath))
+	}
+
+	return append(specs,
+		`sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"`,
+		`"github.com/hashicorp/terraform-plugin-testing/helper/resource"`,
+		`"github.com/hashicorp/terraform-plugin-testing/terraform"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/acctest"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/conns"`,
+	)
+}
+
+func timeoutsOpts(data *APIResourceData) string {
+	var sb strings.Builder
+
+	if data.TimeoutsCreate {
+		sb.WriteString("Create: true,\n")
+	}
+	if data.TimeoutsUpdate {
+		sb.WriteString("Update: true,\n")
+	}
+	if data.TimeoutsDelete {
+		sb.WriteString("Delete: true,\n")
+	}
+
+	return sb.String()
+}
+
+// fieldName returns the model field name for an API member name, e.g. "ARN" for "Arn".
+func fieldName(memberName string) string {
+	for _, v := range []struct{ from, to string }{
+		{"Arns", "ARNs"},
+		{"Arn", "ARN"},
+		{"Ids", "IDs"},
+		{"Id", "ID"},
+	} {
+		if s, ok := strings.CutSuffix(memberName, v.from); ok {
+			return s + v.to
+		}
+	}
+
+	return memberName
+}
+
+// readAttrConstants reads the names package's attribute name constants.
+// Any error is ignored, and literal attribute names are used.
+func readAttrConstants(filename string) map[string]string {
+	attrConsts := make(map[string]string)
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return attrConsts
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return attrConsts
+	}
+
+	for _, record := range records {
+		if len(record) >= 2 {
+			attrConsts[record[0]] = "names.Attr" + record[1]
+		}
+	}
+
+	return attrConsts
+}
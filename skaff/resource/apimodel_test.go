@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"go/format"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+func TestAPIModelTemplates(t *testing.T) {
+	t.Parallel()
+
+	s, err := apimodel.ParseService("github.com/aws/aws-sdk-go-v2/service/example", filepath.Join("..", "apimodel", "testdata", "example"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	td := TemplateData{
+		Resource:             "Widget",
+		ResourceLower:        "widget",
+		ResourceSnake:        "widget",
+		HumanFriendlyService: "Example",
+		ServicePackage:       "example",
+		Service:              "Example",
+		ServiceLower:         "example",
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+		HumanResourceName:    "Widget",
+		ProviderResourceName: "aws_example_widget",
+		ResourceLowerCamel:   "widget",
+	}
+
+	td.API, err = newAPIResourceData(s, "CreateWidget", td.Resource, td.ResourceSnake)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name     string
+		tmpl     string
+		contains []string
+	}{
+		{
+			name: "resource",
+			tmpl: resourceFrameworkAPITmpl,
+			contains: []string{
+				`// @FrameworkResource("aws_example_widget", name="Widget")`,
+				`"github.com/hashicorp/terraform-provider-aws/internal/retry"`,
+				`// @Tags(identifierAttribute="arn")`,
+				`names.AttrARN: framework.ARNAttributeComputedOnly(),`,
+				`CustomType: fwtypes.StringEnumType[awstypes.WidgetStatus](),`,
+				`"configuration": schema.ListNestedBlock{`,
+				`input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))`,
+				`data.ID = fwflex.StringToFramework(ctx, output.Widget.Id)`,
+				`if !new.Description.Equal(old.Description) {`,
+				`input.WidgetIdentifier = aws.String(new.ID.ValueString())`,
+				`Target: enum.Slice(awstypes.WidgetStatusActive),`,
+				`func findWidgetByID(ctx context.Context, conn *example.Client, id string) (*awstypes.Widget, error) {`,
+				"Configuration fwtypes.ListNestedObjectValueOf[widgetConfigurationModel] `tfsdk:\"configuration\"`",
+				"CreatedAt timetypes.RFC3339 `tfsdk:\"created_at\"`",
+				"// TODO Unknown awstypes.Union `tfsdk:\"unknown\"`",
+			},
+		},
+		{
+			name: "test",
+			tmpl: resourceTestFrameworkAPITmpl,
+			contains: []string{
+				`func TestAccExampleWidget_basic(t *testing.T) {`,
+				`var v awstypes.Widget`,
+				`_, err := tfexample.FindWidgetByID(ctx, conn, rs.Primary.ID)`,
+			},
+		},
+		{
+			name: "sweep",
+			tmpl: sweepFrameworkAPITmpl,
+			contains: []string{
+				`pages := example.NewListWidgetsPaginator(conn, input)`,
+				`framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),`,
+			},
+		},
+		{
+			name: "exports",
+			tmpl: exportsFrameworkAPITmpl,
+			contains: []string{
+				`ResourceWidget = newWidgetResource`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			contents, err := executeTemplate(testCase.name, testCase.tmpl, td)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			formatted, err := format.Source(contents)
+
+			if err != nil {
+				t.Fatalf("formatting generated source: %s\n%s", err, contents)
+			}
+
+			// Ignore alignment.
+			got := strings.Join(strings.Fields(string(formatted)), " ")
+			for _, want := range testCase.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("generated source does not contain %q:\n%s", want, formatted)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+const (
+	namesDataFile = "../../../names/data/names_data.hcl"
+)
+
+//go:embed namesdata.tmpl
+var namesDataTmpl string
+
+// NamesData is the names data of a service that is derived from its API model.
+type NamesData struct {
+	ServicePackage    string
+	ServiceID         string
+	ProviderNameUpper string
+	HumanFriendly     string
+	CLIV2Command      string
+	EndpointAPICall   string
+}
+
+// newNamesData derives a service's names data from its API model.
+// The API model has no human-friendly service name, so the service ID is used.
+func newNamesData(servicePackage string, s *apimodel.Service) (*NamesData, error) {
+	if s.ServiceID == "" {
+		return nil, fmt.Errorf("no service ID found in %s", s.ImportPath)
+	}
+
+	nd := &NamesData{
+		ServicePackage:    servicePackage,
+		ServiceID:         s.ServiceID,
+		ProviderNameUpper: regexp.MustCompile(`[^A-Za-z0-9]`).ReplaceAllString(s.ServiceID, ""),
+		HumanFriendly:     s.ServiceID,
+		CLIV2Command:      strings.ToLower(strings.ReplaceAll(s.ServiceID, " ", "-")),
+	}
+
+	// Any paginated List operation can be used to check the service's endpoint.
+	for _, name := range s.Paginators() {
+		if strings.HasPrefix(name, "List") {
+			nd.EndpointAPICall = name
+			break
+		}
+	}
+
+	return nd, nil
+}
+
+// addNamesData adds a service block to the names data, before the first service that sorts after it.
+func addNamesData(filename string, nd *NamesData) error {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("reading names data: %w", err)
+	}
+
+	tplate, err := template.New("namesdata").Parse(namesDataTmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var block bytes.Buffer
+	if err := tplate.Execute(&block, nd); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	offset := len(contents)
+	for _, match := range regexp.MustCompile(`(?m)^service "([^"]+)" \{`).FindAllSubmatchIndex(contents, -1) {
+		if string(contents[match[2]:match[3]]) > nd.ServicePackage {
+			offset = match[0]
+			break
+		}
+	}
+
+	var buffer bytes.Buffer
+	buffer.Write(contents[:offset])
+	if offset == len(contents) && !bytes.HasSuffix(contents, []byte("\n\n")) {
+		buffer.WriteString("\n")
+	}
+	buffer.Write(block.Bytes())
+	if offset < len(contents) {
+		buffer.WriteString("\n")
+	}
+	buffer.Write(contents[offset:])
+
+	return os.WriteFile(filename, buffer.Bytes(), 0644)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+func TestAddNamesData(t *testing.T) {
+	t.Parallel()
+
+	s, err := apimodel.ParseService("github.com/aws/aws-sdk-go-v2/service/example", filepath.Join("..", "apimodel", "testdata", "example"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nd, err := newNamesData("example", s)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := nd.ProviderNameUpper, "ExampleService"; got != want {
+		t.Errorf("ProviderNameUpper = %q, want %q", got, want)
+	}
+	if got, want := nd.CLIV2Command, "example-service"; got != want {
+		t.Errorf("CLIV2Command = %q, want %q", got, want)
+	}
+	if got, want := nd.EndpointAPICall, "ListWidgets"; got != want {
+		t.Errorf("EndpointAPICall = %q, want %q", got, want)
+	}
+
+	filename := filepath.Join(t.TempDir(), "names_data.hcl")
+	if err := os.WriteFile(filename, []byte("service \"accessanalyzer\" {\n}\n\nservice \"fsx\" {\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := addNamesData(filename, nd); err != nil {
+		t.Fatal(err)
+	}
+
+	contents, err := os.ReadFile(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(contents)
+
+	for _, want := range []string{
+		"}\n\nservice \"example\" {\n",
+		`id             = "Example Service"`,
+		`provider_name_upper = "ExampleService"`,
+		`endpoint_api_call        = "ListWidgets"`,
+		`correct = "aws_example_"`,
+		"}\n\nservice \"fsx\" {\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("names data does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if a, e, f := strings.Index(got, `"accessanalyzer"`), strings.Index(got, `"example"`), strings.Index(got, `"fsx"`); a > e || e > f {
+		t.Errorf("services out of order:\n%s", got)
+	}
+}
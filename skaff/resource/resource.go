@@ -8,6 +8,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -15,9 +16,14 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
 )
 
+const (
+	sdkV2ServiceImportPath = "github.com/aws/aws-sdk-go-v2/service/"
+)
+
 //go:embed resource.tmpl
 var resourceTmpl string
 
@@ -30,6 +36,18 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed resourcefwapi.tmpl
+var resourceFrameworkAPITmpl string
+
+//go:embed resourcetestfwapi.tmpl
+var resourceTestFrameworkAPITmpl string
+
+//go:embed sweepfwapi.tmpl
+var sweepFrameworkAPITmpl string
+
+//go:embed exportsfwapi.tmpl
+var exportsFrameworkAPITmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -45,9 +63,13 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	ResourceLowerCamel   string
+	API                  *APIResourceData
 }
 
-func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
+// Create generates the scaffolding for a resource.
+// If operation is set, e.g. "CreateWidget", the resource is generated from the AWS SDK for Go v2 API model.
+func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool, operation string) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -55,6 +77,16 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 
 	servicePackage := filepath.Base(wd)
 
+	if operation != "" {
+		if !v2 || !pluginFramework {
+			return fmt.Errorf("error checking: generating from an operation requires AWS SDK for Go v2 and Terraform Plugin Framework")
+		}
+
+		if resName == "" {
+			resName = strings.TrimPrefix(operation, "Create")
+		}
+	}
+
 	if resName == "" {
 		return fmt.Errorf("error checking: no name given")
 	}
@@ -69,19 +101,32 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 
 	snakeName = convert.ToSnakeCase(resName, snakeName)
 
-	s, err := names.ProviderNameUpper(servicePackage)
-	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+	var nd *NamesData
+	if _, err := names.ProviderNameUpper(servicePackage); err != nil && operation != "" {
+		// Pre-populate the names data of a service that has none, from the API model.
+		if nd, err = createNamesData(servicePackage); err != nil {
+			return err
+		}
 	}
 
-	sn, err := names.FullHumanFriendly(servicePackage)
-	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
-	}
+	var s, sn, hf string
+	if nd != nil {
+		s, sn, hf = nd.ProviderNameUpper, "AWS "+nd.HumanFriendly, nd.HumanFriendly
+	} else {
+		s, err = names.ProviderNameUpper(servicePackage)
+		if err != nil {
+			return fmt.Errorf("error getting service connection name: %w", err)
+		}
 
-	hf, err := names.HumanFriendly(servicePackage)
-	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		sn, err = names.FullHumanFriendly(servicePackage)
+		if err != nil {
+			return fmt.Errorf("error getting AWS service name: %w", err)
+		}
+
+		hf, err = names.HumanFriendly(servicePackage)
+		if err != nil {
+			return fmt.Errorf("error getting human-friendly name: %w", err)
+		}
 	}
 
 	templateData := TemplateData{
@@ -99,6 +144,11 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		PluginFramework:      pluginFramework,
 		HumanResourceName:    convert.ToHumanResName(resName),
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+		ResourceLowerCamel:   convert.ToLowercasePrefix(resName),
+	}
+
+	if operation != "" {
+		return createFromAPIModel(servicePackage, operation, force, templateData)
 	}
 
 	tmpl := resourceTmpl
@@ -124,14 +174,108 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 	return nil
 }
 
+// createNamesData adds names data for a service, derived from the API model of the AWS SDK for Go v2 package of the same name.
+func createNamesData(servicePackage string) (*NamesData, error) {
+	s, err := apimodel.LoadService(sdkV2ServiceImportPath + servicePackage)
+	if err != nil {
+		return nil, fmt.Errorf("error loading API model: %w", err)
+	}
+
+	nd, err := newNamesData(servicePackage, s)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving names data from API model: %w", err)
+	}
+
+	if err := addNamesData(namesDataFile, nd); err != nil {
+		return nil, fmt.Errorf("error adding names data: %w", err)
+	}
+
+	fmt.Printf("Added %s to %s: review it, then run `make gen` to generate the service package\n", servicePackage, namesDataFile)
+
+	return nd, nil
+}
+
+func createFromAPIModel(servicePackage, operation string, force bool, td TemplateData) error {
+	goV2Package, err := names.AWSGoV2Package(servicePackage)
+	if err != nil {
+		// Services whose names data has just been added.
+		goV2Package = servicePackage
+	}
+
+	s, err := apimodel.LoadService(sdkV2ServiceImportPath + goV2Package)
+	if err != nil {
+		return fmt.Errorf("error loading API model: %w", err)
+	}
+
+	td.API, err = newAPIResourceData(s, operation, td.Resource, td.ResourceSnake)
+	if err != nil {
+		return fmt.Errorf("error deriving resource from API model: %w", err)
+	}
+	td.IncludeTags = td.API.Tags
+
+	f := fmt.Sprintf("%s.go", td.ResourceSnake)
+	if err = writeSourceTemplate("newres", f, resourceFrameworkAPITmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", td.ResourceSnake)
+	if err = writeSourceTemplate("restest", tf, resourceTestFrameworkAPITmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	// Add to any existing exports and sweepers by hand.
+	if err = writeOrPrintSourceTemplate("exports", "exports_test.go", exportsFrameworkAPITmpl, "vars", td); err != nil {
+		return fmt.Errorf("writing exports template: %w", err)
+	}
+
+	if td.API.List != "" && td.API.ListOutputMember != "" && td.API.ListIdentifier != "" {
+		if err = writeOrPrintSourceTemplate("sweep", "sweep.go", sweepFrameworkAPITmpl, "sweeper", td); err != nil {
+			return fmt.Errorf("writing sweeper template: %w", err)
+		}
+	} else {
+		fmt.Printf("No sweeper generated: %s has no paginated List%s operation\n", td.API.ImportPath, td.Resource)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, td.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
-	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	contents, err := executeTemplate(templateName, tmpl, td)
+	if err != nil {
+		return err
 	}
 
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	return writeFile(filename, contents, force)
+}
+
+// writeSourceTemplate writes a template's Go source output, formatted.
+func writeSourceTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	contents, err := executeTemplate(templateName, tmpl, td)
 	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
+		return err
+	}
+
+	formatted, err := format.Source(contents)
+	if err != nil {
+		// Write the unformatted source to aid debugging.
+		fmt.Printf("error formatting generated file (%s): %s\n", filename, err)
+		formatted = contents
+	}
+
+	return writeFile(filename, formatted, force)
+}
+
+// writeOrPrintSourceTemplate writes a template's Go source output if the file doesn't exist,
+// otherwise it prints the named template's output to be added to the existing file.
+func writeOrPrintSourceTemplate(templateName, filename, tmpl, snippetName string, td TemplateData) error {
+	if _, err := os.Stat(filename); errors.Is(err, fs.ErrNotExist) {
+		return writeSourceTemplate(templateName, filename, tmpl, false, td)
 	}
 
 	tplate, err := template.New(templateName).Parse(tmpl)
@@ -139,19 +283,42 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error parsing template: %s", err)
 	}
 
+	var buffer bytes.Buffer
+	if err := tplate.ExecuteTemplate(&buffer, snippetName, td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	fmt.Printf("Add to %s:\n\n%s\n\n", filename, buffer.String())
+
+	return nil
+}
+
+func executeTemplate(templateName, tmpl string, td TemplateData) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
 	var buffer bytes.Buffer
 	err = tplate.Execute(&buffer, td)
 	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+		return nil, fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	return buffer.Bytes(), nil
+}
+
+func writeFile(filename string, contents []byte, force bool) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
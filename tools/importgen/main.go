@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+var (
+	listTypes = flag.Bool("list-types", false, "List the supported resource types and exit")
+	output    = flag.String("output", "", "File that import blocks are written to (default is stdout)")
+	regions   = flag.String("regions", "", "Comma-separated list of AWS Regions to list resources in. The first Region is the provider's Region")
+	tags      = flag.String("tags", "", "Comma-separated list of tags, as key or key=value, that imported resources must have")
+	types     = flag.String("types", "", "Comma-separated list of resource types to import (default is all supported types)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\timportgen -regions <regions> [-types <resource-types>] [-tags <tags>] [-output <file>]\n")
+	fmt.Fprintf(os.Stderr, "\timportgen -list-types\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	sweep.ServicePackages = servicePackages(ctx)
+	registerSweepers()
+
+	if *listTypes {
+		for _, v := range sweep.Listable() {
+			fmt.Println(v)
+		}
+		return
+	}
+
+	regionNames := splitList(*regions)
+	if len(regionNames) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	typeNames := sweep.Listable()
+	if v := splitList(*types); len(v) > 0 {
+		for _, typeName := range v {
+			if !slices.Contains(typeNames, typeName) {
+				g.Fatalf("resource type (%s) is not supported, run importgen -list-types for supported types", typeName)
+			}
+		}
+		typeNames = v
+	}
+
+	filter, err := newTagFilter(*tags)
+	if err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	resources, err := listResources(regionNames, typeNames, filter)
+	if err != nil {
+		g.Errorf("%s", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			g.Fatalf("creating %s: %s", *output, err)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	if err := writeImportBlocks(w, resources, regionNames[0]); err != nil {
+		g.Fatalf("writing import blocks: %s", err)
+	}
+
+	g.Infof("Generated %d import blocks", len(resources))
+}
+
+// importableResource is a resource that can be imported into Terraform state.
+type importableResource struct {
+	TypeName string
+	ID       string // The ID used to import the resource.
+	Region   string
+}
+
+// listResources lists the importable resources of the specified types in each AWS Region, using the types' sweepers.
+// Resources that cannot be imported or that are not selected by filter are skipped.
+// Any errors listing resources are returned along with the resources that were listed.
+func listResources(regions, typeNames []string, filter *sweep.Filter) ([]importableResource, error) {
+	var (
+		errs      []error
+		resources []importableResource
+	)
+
+	for _, region := range regions {
+		for _, typeName := range typeNames {
+			sweepables, err := sweep.List(region, typeName)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			ctx := sweep.Context(region)
+
+			for _, sweepable := range sweepables {
+				importable, ok := sweepable.(sweep.Importable)
+				if !ok {
+					continue
+				}
+
+				id, ok := importable.ImportID(ctx)
+				if !ok {
+					continue
+				}
+
+				ok, _, err := filter.Match(ctx, sweepable)
+				if tfresource.NotFound(err) {
+					continue
+				}
+				if err != nil {
+					errs = append(errs, fmt.Errorf("inspecting %s (%s) in %s: %w", typeName, id, region, err))
+					continue
+				}
+				if !ok {
+					continue
+				}
+
+				resources = append(resources, importableResource{
+					TypeName: typeName,
+					ID:       id,
+					Region:   region,
+				})
+			}
+		}
+	}
+
+	return resources, errors.Join(errs...)
+}
+
+// writeImportBlocks writes an import block for each resource.
+// Resources in AWS Regions other than the provider's Region are imported using an ID of the form `<id>@<region>`.
+func writeImportBlocks(w io.Writer, resources []importableResource, providerRegion string) error {
+	labels := make(map[string]int)
+
+	for i, resource := range resources {
+		id := resource.ID
+		label := resourceLabel(resource.ID)
+		if resource.Region != providerRegion {
+			id = fmt.Sprintf("%s@%s", id, resource.Region)
+			label = resourceLabel(fmt.Sprintf("%s_%s", resource.ID, resource.Region))
+		}
+
+		address := fmt.Sprintf("%s.%s", resource.TypeName, label)
+		labels[address]++
+		if n := labels[address]; n > 1 {
+			address = fmt.Sprintf("%s_%d", address, n)
+		}
+
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(w, "import {\n  to = %s\n  id = %s\n}\n", address, quote(id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceLabel returns a valid resource name derived from the specified value.
+func resourceLabel(s string) string {
+	label := strings.Map(func(r rune) rune {
+		if r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, s)
+
+	if label == "" || !(unicode.IsLetter(rune(label[0])) || label[0] == '_') {
+		label = "r_" + label
+	}
+
+	return label
+}
+
+// quote returns the specified value as a quoted HCL string literal.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	).Replace(s) + `"`
+}
+
+// newTagFilter returns a filter selecting resources with the specified comma-separated tags, as key or key=value.
+func newTagFilter(s string) (*sweep.Filter, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range strings.Split(s, ",") {
+		key, value, _ := strings.Cut(tag, "=")
+		if key = strings.TrimSpace(key); key == "" {
+			return nil, fmt.Errorf("parsing -tags (%s): empty tag key", s)
+		}
+		tags[key] = strings.TrimSpace(value)
+	}
+
+	return &sweep.Filter{Tags: tags}, nil
+}
+
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
@@ -3,6 +3,7 @@ module github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw
 go 1.22.4
 
 require (
+	github.com/google/go-cmp v0.6.0
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.34.0
 	github.com/hashicorp/terraform-provider-aws v1.60.1-0.20220322001452-8f7a597d0c24
 	golang.org/x/exp v0.0.0-20240222234643-814bf88cf225
@@ -154,6 +155,8 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mq v1.23.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/mwaa v1.28.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/neptunegraph v1.9.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.39.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/networkmonitor v1.4.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/oam v1.12.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.12.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/organizations v1.28.1 // indirect
@@ -229,7 +232,6 @@ require (
 	github.com/go-logr/logr v1.4.1 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/golang/protobuf v1.5.4 // indirect
-	github.com/google/go-cmp v0.6.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go v0.23.0 // indirect
 	github.com/hashicorp/aws-sdk-go-base/v2 v2.0.0-beta.54 // indirect
@@ -13,10 +13,12 @@ import (
 	"path"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -85,6 +87,22 @@ func main() {
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
 		migrator.TFTypeName = v
+
+		if resource.MigrateState != nil {
+			g.Warnf("resource type %s has a legacy MigrateState function which is not migrated", v)
+		}
+
+		// Find the resource's CRUD handlers, finders and waiters in the existing package source.
+		source, err := loadPackageSource(path.Dir(outputFilename), outputFilename)
+
+		if err != nil {
+			g.Warnf("loading package source: %s", err)
+		} else if sdkResource, err := source.sdkResource(v); err != nil {
+			g.Warnf("finding resource type %s: %s", v, err)
+		} else {
+			migrator.Source = source
+			migrator.SDKResource = sdkResource
+		}
 	}
 
 	if err := migrator.migrate(outputFilename); err != nil {
@@ -98,6 +116,8 @@ type migrator struct {
 	Name         string
 	PackageName  string
 	Resource     *schema.Resource
+	SDKResource  *sdkResource   // The resource's implementation. May be nil.
+	Source       *packageSource // The resource's package source. May be nil.
 	Template     string
 	TFTypeName   string
 }
@@ -153,9 +173,13 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		EmitResourceImportState:      m.Resource.Importer != nil,
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasTags:                      emitter.HasTopLevelTagsMap,
 		HasTimeouts:                  emitter.HasTimeouts,
+		HumanName:                    m.Name,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		ImportProviderTags:           emitter.ImportProviderTags,
+		Models:                       strings.Join(emitter.Models, "\n"),
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
@@ -163,6 +187,10 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		m.generateCRUDTemplateData(templateData)
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -182,6 +210,100 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// generateCRUDTemplateData populates the template data used to generate a resource's CRUD handlers.
+// API operations, finders and waiters are taken from the Plugin SDK resource's implementation, if found.
+func (m *migrator) generateCRUDTemplateData(templateData *templateData) {
+	humanName := m.Name
+	if r := m.SDKResource; r != nil && r.HumanName != "" {
+		humanName = r.HumanName
+	}
+	if v, err := names.HumanFriendly(m.PackageName); err == nil {
+		humanName = v + " " + humanName
+	}
+	templateData.HumanName = humanName
+
+	if v, err := names.ProviderNameUpper(m.PackageName); err == nil {
+		if names.ClientSDKV1(m.PackageName) {
+			templateData.ConnMethod = v + "Conn"
+		} else {
+			templateData.ConnMethod = v + "Client"
+		}
+	}
+
+	templateData.CreateTimeout = durationExpr(templateData.DefaultCreateTimeout)
+	templateData.ReadTimeout = durationExpr(templateData.DefaultReadTimeout)
+	templateData.UpdateTimeout = durationExpr(templateData.DefaultUpdateTimeout)
+	templateData.DeleteTimeout = durationExpr(templateData.DefaultDeleteTimeout)
+
+	if r, s := m.SDKResource, m.Source; r != nil && s != nil {
+		templateData.Annotations = r.Annotations
+
+		if v := r.ConnMethod; v != "" {
+			templateData.ConnMethod = v
+		}
+
+		templateData.CreateOperation = s.apiOperation(r.Create)
+		templateData.UpdateOperation = s.apiOperation(r.Update)
+		templateData.DeleteOperation = s.apiOperation(r.Delete)
+		templateData.Finder = s.finder(r.Name)
+
+		if templateData.DefaultCreateTimeout > 0 {
+			templateData.CreateWaiter = s.waiter(r.Name, "Created", "Available", "Ready")
+		}
+		if templateData.DefaultUpdateTimeout > 0 {
+			templateData.UpdateWaiter = s.waiter(r.Name, "Updated")
+		}
+		if templateData.DefaultDeleteTimeout > 0 {
+			templateData.DeleteWaiter = s.waiter(r.Name, "Deleted")
+		}
+
+		// A Plugin Framework state upgrader upgrades from its version directly to the current version,
+		// so chain each Plugin SDK state upgrader with all those for later versions.
+		for i, v := range r.StateUpgraders {
+			upgrader := templateStateUpgrader{
+				Version: v.Version,
+			}
+
+			for _, v := range r.StateUpgraders[i:] {
+				if v.FuncName == "" {
+					m.Generator.Warnf("state upgrader for version %d is not a named function", v.Version)
+					upgrader.FuncNames = nil
+					break
+				}
+
+				upgrader.FuncNames = append(upgrader.FuncNames, v.FuncName)
+			}
+
+			templateData.StateUpgraders = append(templateData.StateUpgraders, upgrader)
+		}
+	} else {
+		m.Generator.Warnf("resource implementation not found, CRUD handlers will be skeletons")
+	}
+
+	for _, v := range []*apiOperation{templateData.CreateOperation, templateData.UpdateOperation, templateData.DeleteOperation} {
+		if v == nil {
+			continue
+		}
+
+		templateData.ImportFlex = true
+
+		if !slices.Contains(templateData.AWSSDKImports, v.Import) {
+			templateData.AWSSDKImports = append(templateData.AWSSDKImports, v.Import)
+		}
+	}
+	sort.Strings(templateData.AWSSDKImports)
+
+	if templateData.Finder != "" {
+		templateData.ImportFlex = true
+		templateData.ImportFWDiag = true
+		templateData.ImportTFResource = true
+	}
+
+	templateData.ImportFmt = templateData.Finder != "" || templateData.UpdateOperation != nil || templateData.DeleteOperation != nil ||
+		templateData.CreateWaiter != "" || templateData.UpdateWaiter != "" || templateData.DeleteWaiter != ""
+	templateData.ImportTime = templateData.CreateTimeout != "" || templateData.ReadTimeout != "" || templateData.UpdateTimeout != "" || templateData.DeleteTimeout != ""
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
@@ -199,7 +321,9 @@ type emitter struct {
 	HasTopLevelTagsMap            bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportProviderTags            bool
 	IsDataSource                  bool
+	Models                        []string // Nested object model structs.
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
@@ -236,7 +360,7 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 	fprintf(e.SchemaWriter, "schema.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	err := e.emitAttributesAndBlocks(nil, resource.Schema, e.StructWriter)
 
 	if err != nil {
 		return err
@@ -262,7 +386,8 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // emitAttributesAndBlocks generates the Plugin Framework code for a set of Plugin SDK Attributes and Blocks
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
+// Model struct fields are emitted to the specified Writer.
+func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema, structWriter io.Writer) error {
 	isTopLevelAttribute := len(path) == 0
 
 	// At this point we are emitting code for a schema.Block or Schema.
@@ -286,21 +411,33 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(structWriter, "%s ", naming.ToCamelCase(name))
+
+		if isTopLevelAttribute && !e.IsDataSource && isTagsAttribute(name, property) {
+			// Special handling for 'tags' and 'tags_all'.
+			e.ImportProviderTags = true
+
+			if name == "tags" {
+				e.HasTopLevelTagsMap = true
+				fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+			} else {
+				e.HasTopLevelTagsAllMap = true
+				fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
-		err := e.emitAttributeProperty(append(path, name), property)
-
-		if err != nil {
-			return err
-		}
+			fprintf(structWriter, "types.Map")
+		} else if isTopLevelAttribute && !e.IsDataSource && name == "id" && property.Computed && !property.Optional {
+			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(structWriter, "types.String")
+		} else {
+			err := e.emitAttributeProperty(append(path, name), property, structWriter)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+			if err != nil {
+				return err
+			}
 		}
 
+		fprintf(structWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -321,13 +458,15 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(structWriter, "%s ", naming.ToCamelCase(name))
 
-		err := e.emitBlockProperty(append(path, name), property)
+		err := e.emitBlockProperty(append(path, name), property, structWriter)
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(structWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -339,7 +478,8 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+// The attribute's model struct field type is emitted to the specified Writer.
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema, structWriter io.Writer) error {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
 	isTopLevelAttribute := len(path) == 1
@@ -354,9 +494,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(structWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +502,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(structWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +510,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(structWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,19 +523,14 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(structWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
 			}
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(structWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,10 +547,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -431,10 +556,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -444,32 +565,44 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 		}
 
+		// Model struct field types.
+		aggregateType := "types." + fwPlanModifierType
+		aggregateCustomType := "fwtypes." + fwPlanModifierType
+
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
+			var customType, elementType string
 
 			switch v := v.Type; v {
 			case schema.TypeBool:
 				elementType = "types.BoolType"
+				fprintf(structWriter, "%s", aggregateType)
 
 			case schema.TypeFloat:
 				elementType = "types.Float64Type"
+				fprintf(structWriter, "%s", aggregateType)
 
 			case schema.TypeInt:
 				elementType = "types.Int64Type"
+				fprintf(structWriter, "%s", aggregateType)
 
 			case schema.TypeString:
 				elementType = "types.StringType"
+				if isTopLevelAttribute && isTagsAttribute(attributeName, property) {
+					// Tags are handled as plain maps.
+					fprintf(structWriter, "%s", aggregateType)
+				} else {
+					// For example fwtypes.ListValueOf[types.String] of type fwtypes.ListOfStringType.
+					e.ImportProviderFrameworkTypes = true
+					customType = aggregateCustomType + "OfStringType"
+					fprintf(structWriter, "%sValueOf[types.String]", aggregateCustomType)
+				}
 				// Special handling for 'tags' and 'tags_all'.
 				if typeName == "map" && isTopLevelAttribute {
 					if attributeName == "tags" {
@@ -490,10 +623,14 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			if customType != "" {
+				fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
+			}
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
+			fprintf(structWriter, "%s", aggregateType)
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
 			fprintf(e.SchemaWriter, "ElementType:")
 
@@ -597,7 +734,9 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+// The block's model struct field type is emitted to the specified Writer and the nested block's
+// model struct is added to the emitter's models.
+func (e *emitter) emitBlockProperty(path []string, property *schema.Schema, structWriter io.Writer) error {
 	var planModifiers []string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
@@ -614,10 +753,16 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := modelStructName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			fprintf(structWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
+
+			err := e.emitNestedObjectModel(path, modelName, v.Schema)
 
 			if err != nil {
 				return err
@@ -637,10 +782,16 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := modelStructName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			fprintf(structWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
+
+			err := e.emitNestedObjectModel(path, modelName, v.Schema)
 
 			if err != nil {
 				return err
@@ -712,6 +863,25 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitNestedObjectModel generates the Plugin Framework code for a Plugin SDK Block's nested object
+// and emits the nested object's model struct to the emitter's models.
+// The parent model struct is emitted before any child model structs.
+func (e *emitter) emitNestedObjectModel(path []string, modelName string, schema map[string]*schema.Schema) error {
+	sbStruct := strings.Builder{}
+	i := len(e.Models)
+	e.Models = append(e.Models, "")
+
+	err := e.emitAttributesAndBlocks(path, schema, &sbStruct)
+
+	if err != nil {
+		return err
+	}
+
+	e.Models[i] = fmt.Sprintf("type %s struct {\n%s}\n", modelName, sbStruct.String())
+
+	return nil
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -878,29 +1048,103 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// isTagsAttribute returns whether the specified top-level Plugin SDK Attribute is 'tags' or 'tags_all'.
+func isTagsAttribute(name string, property *schema.Schema) bool {
+	if name != "tags" && name != "tags_all" {
+		return false
+	}
+
+	if property.Type != schema.TypeMap {
+		return false
+	}
+
+	v, ok := property.Elem.(*schema.Schema)
+
+	return ok && v.Type == schema.TypeString
+}
+
+// modelStructName returns the name of the model struct for the nested block at the specified path.
+// For example, "health_check/config" is modelled by "healthCheckConfigModel".
+func modelStructName(path []string) string {
+	s := naming.ToCamelCase(strings.Join(path, "_"))
+
+	if s == "" {
+		return "model"
+	}
+
+	return strings.ToLower(s[:1]) + s[1:] + "Model"
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
 type templateData struct {
+	Annotations                   []string // Annotations copied from the Plugin SDK resource, e.g. `@Tags(identifierAttribute="id")`.
+	AWSSDKImports                 []string // Import specs for AWS SDK for Go packages.
+	ConnMethod                    string   // e.g. EC2Client
+	CreateOperation               *apiOperation
+	CreateTimeout                 string // e.g. 10 * time.Minute
+	CreateWaiter                  string // e.g. waitInstanceCreated
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
 	DefaultDeleteTimeout          int64
+	DeleteOperation               *apiOperation
+	DeleteTimeout                 string
+	DeleteWaiter                  string
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
+	Finder                        string // e.g. findInstanceByID
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
+	HasTags                       bool
 	HasTimeouts                   bool
+	HumanName                     string // e.g. EC2 Instance
+	ImportFlex                    bool
+	ImportFmt                     bool
 	ImportFrameworkAttr           bool
+	ImportFWDiag                  bool
 	ImportProviderFrameworkTypes  bool
+	ImportProviderTags            bool
+	ImportTFResource              bool
+	ImportTime                    bool
+	Models                        string // Nested object model structs.
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	ProviderPlanModifierPackages  []string
+	ReadTimeout                   string
 	Schema                        string
+	StateUpgraders                []templateStateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	UpdateOperation               *apiOperation
+	UpdateTimeout                 string
+	UpdateWaiter                  string
+}
+
+// templateStateUpgrader is a Plugin Framework state upgrader composed of Plugin SDK state upgrade functions.
+type templateStateUpgrader struct {
+	Version   int
+	FuncNames []string // Empty if any of the Plugin SDK state upgrade functions can't be called.
+}
+
+// durationExpr returns a human-friendly Go expression for the specified duration in nanoseconds, e.g. "10 * time.Minute".
+// An empty string is returned for a zero duration.
+func durationExpr(d int64) string {
+	switch v := time.Duration(d); {
+	case v <= 0:
+		return ""
+	case v%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", int64(v/time.Hour))
+	case v%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", int64(v/time.Minute))
+	case v%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", int64(v/time.Second))
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", d)
+	}
 }
 
 //go:embed datasource.tmpl
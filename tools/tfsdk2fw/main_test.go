@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TestMigrateResourceCompiles renders resource.tmpl for a resource without an implementation in its package source
+// and checks that the generated source compiles.
+func TestMigrateResourceCompiles(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping compilation of generated source in short mode")
+	}
+
+	// Generate into this module so that the generated source is compiled using the module's dependencies.
+	dir, err := os.MkdirTemp(".", "compiletest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	m := &migrator{
+		Generator:   common.NewGenerator(),
+		Name:        "Widget",
+		PackageName: "example",
+		Resource:    testResource(),
+		Template:    resourceImpl,
+		TFTypeName:  "aws_example_widget",
+	}
+
+	if err := m.migrate(filepath.Join(dir, "widget_fw.go")); err != nil {
+		t.Fatalf("migrating resource: %s", err)
+	}
+
+	cmd := exec.Command("go", "build", "-mod=readonly", "./"+dir)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		source, _ := os.ReadFile(filepath.Join(dir, "widget_fw.go"))
+		t.Fatalf("compiling generated source: %s\n%s\n%s", err, output, source)
+	}
+}
+
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		Create: func(*schema.ResourceData, any) error { return nil },
+		Read:   func(*schema.ResourceData, any) error { return nil },
+		Update: func(*schema.ResourceData, any) error { return nil },
+		Delete: func(*schema.ResourceData, any) error { return nil },
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrPriority: {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
@@ -0,0 +1,460 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// packageSource holds the top-level function declarations of a service package's Go source.
+type packageSource struct {
+	funcs map[string]sourceFunc
+}
+
+// sourceFunc is a top-level function declaration along with the file that it's declared in.
+type sourceFunc struct {
+	decl *ast.FuncDecl
+	file *ast.File
+}
+
+// sdkResource describes the implementation of a Plugin SDK resource, as found in its package's source.
+type sdkResource struct {
+	Annotations    []string // Annotations other than @SDKResource, e.g. `@Tags(identifierAttribute="id")`.
+	ConnMethod     string   // AWSClient method returning the API client, e.g. "EC2Client".
+	FuncName       string   // e.g. "resourceInstance"
+	HumanName      string   // From the @SDKResource annotation, e.g. "Instance".
+	Name           string   // From the function name, e.g. "Instance".
+	Create         string   // e.g. "resourceInstanceCreate"
+	Read           string
+	Update         string
+	Delete         string
+	StateUpgraders []sdkStateUpgrader
+}
+
+// sdkStateUpgrader is a Plugin SDK resource state upgrader.
+type sdkStateUpgrader struct {
+	Version  int
+	FuncName string // Empty if the upgrade function isn't a named function.
+}
+
+// apiOperation is the AWS API operation called by a Plugin SDK CRUD handler.
+type apiOperation struct {
+	Import    string // Import of the AWS SDK for Go package, e.g. `"github.com/aws/aws-sdk-go-v2/service/ec2"`.
+	InputType string // e.g. "ec2.RunInstancesInput"
+	Method    string // API client method, e.g. "RunInstances" or "RunInstancesWithContext"
+	name      string // e.g. "RunInstances"
+}
+
+var (
+	sdkResourceAnnotationRegexp = regexp.MustCompile(`^@SDKResource\("([^"]+)"(?:,\s*name="([^"]*)")?\)`)
+)
+
+// loadPackageSource parses the (non-test) Go source files in the specified directory.
+// Any file named exclude, typically the file being generated, is skipped.
+func loadPackageSource(dir, exclude string) (*packageSource, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading directory %s: %w", dir, err)
+	}
+
+	fset := token.NewFileSet()
+	s := &packageSource{
+		funcs: make(map[string]sourceFunc),
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		filename := filepath.Join(dir, name)
+
+		if exclude != "" && filepath.Clean(filename) == filepath.Clean(exclude) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil {
+				s.funcs[decl.Name.Name] = sourceFunc{decl: decl, file: file}
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// sdkResource returns the implementation of the Plugin SDK resource with the specified Terraform type name.
+// The resource is found by its @SDKResource annotation.
+func (s *packageSource) sdkResource(typeName string) (*sdkResource, error) {
+	for _, name := range s.funcNames() {
+		f := s.funcs[name]
+
+		if f.decl.Doc == nil {
+			continue
+		}
+
+		var (
+			found       bool
+			annotations []string
+			humanName   string
+		)
+		for _, line := range strings.Split(f.decl.Doc.Text(), "\n") {
+			line = strings.TrimSpace(line)
+
+			if m := sdkResourceAnnotationRegexp.FindStringSubmatch(line); m != nil {
+				if m[1] == typeName {
+					found = true
+					humanName = m[2]
+				}
+			} else if strings.HasPrefix(line, "@") {
+				annotations = append(annotations, line)
+			}
+		}
+
+		if !found {
+			continue
+		}
+
+		r := &sdkResource{
+			Annotations: annotations,
+			FuncName:    name,
+			HumanName:   humanName,
+			Name:        strings.TrimPrefix(strings.TrimPrefix(name, "resource"), "Resource"),
+		}
+
+		ast.Inspect(f.decl.Body, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok || !isSelector(lit.Type, "schema", "Resource") {
+				return true
+			}
+
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				if !ok {
+					continue
+				}
+
+				switch key.Name {
+				case "Create", "CreateContext", "CreateWithoutTimeout":
+					r.Create = identName(kv.Value)
+				case "Read", "ReadContext", "ReadWithoutTimeout":
+					r.Read = identName(kv.Value)
+				case "Update", "UpdateContext", "UpdateWithoutTimeout":
+					r.Update = identName(kv.Value)
+				case "Delete", "DeleteContext", "DeleteWithoutTimeout":
+					r.Delete = identName(kv.Value)
+				case "StateUpgraders":
+					r.StateUpgraders = stateUpgraders(kv.Value)
+				}
+			}
+
+			// Only the outermost resource is of interest.
+			return false
+		})
+
+		for _, v := range []string{r.Read, r.Create, r.Update, r.Delete} {
+			if r.ConnMethod = s.connMethod(v); r.ConnMethod != "" {
+				break
+			}
+		}
+
+		return r, nil
+	}
+
+	return nil, fmt.Errorf("no function annotated @SDKResource(%q) found", typeName)
+}
+
+// apiOperation returns the first AWS API operation called by the specified function.
+// The operation is identified by an `<Operation>Input` struct literal followed by a call to an API client method
+// named for the operation, e.g. `conn.RunInstances(ctx, input)`.
+func (s *packageSource) apiOperation(funcName string) *apiOperation {
+	f, ok := s.funcs[funcName]
+	if !ok || f.decl.Body == nil {
+		return nil
+	}
+
+	var op *apiOperation
+	ast.Inspect(f.decl.Body, func(n ast.Node) bool {
+		if op != nil && op.Method != "" {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.CompositeLit:
+			// Any later input supersedes an earlier one whose operation isn't called.
+			sel, ok := n.Type.(*ast.SelectorExpr)
+			if !ok || !strings.HasSuffix(sel.Sel.Name, "Input") {
+				return true
+			}
+			pkg, ok := sel.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			importSpec := fileImport(f.file, pkg.Name)
+			if importSpec == "" {
+				return true
+			}
+
+			op = &apiOperation{
+				Import:    importSpec,
+				InputType: pkg.Name + "." + sel.Sel.Name,
+				name:      strings.TrimSuffix(sel.Sel.Name, "Input"),
+			}
+
+		case *ast.CallExpr:
+			if op == nil {
+				return true
+			}
+
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok || len(n.Args) < 2 {
+				return true
+			}
+			if _, ok := sel.X.(*ast.Ident); !ok {
+				return true
+			}
+
+			if sel.Sel.Name == op.name || sel.Sel.Name == op.name+"WithContext" {
+				op.Method = sel.Sel.Name
+			}
+		}
+
+		return true
+	})
+
+	if op == nil || op.Method == "" {
+		return nil
+	}
+
+	return op
+}
+
+// finder returns the name of the package's finder function for the named resource, e.g. `findInstanceByID`.
+// Only finders of the form `func(ctx, conn, id string) (..., error)` are considered.
+func (s *packageSource) finder(resourceName string) string {
+	for _, name := range candidateFuncNames("find", resourceName, "ByID", "ByARN", "ByName", "") {
+		if f, ok := s.funcs[name]; ok && paramCount(f.decl) == 3 && resultCount(f.decl) == 2 && isStringParam(f.decl, 2) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// waiter returns the name of the package's waiter function for the named resource reaching one of the specified states,
+// e.g. `waitInstanceCreated`.
+// Only waiters of the form `func(ctx, conn, id string, timeout time.Duration) (..., error)` are considered.
+func (s *packageSource) waiter(resourceName string, states ...string) string {
+	for _, name := range candidateFuncNames("wait", resourceName, states...) {
+		if f, ok := s.funcs[name]; ok && paramCount(f.decl) == 4 && resultCount(f.decl) == 2 && isStringParam(f.decl, 2) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// connMethod returns the name of the AWSClient method called by the specified function to get its API client,
+// e.g. "EC2Client" for `meta.(*conns.AWSClient).EC2Client(ctx)`.
+func (s *packageSource) connMethod(funcName string) string {
+	f, ok := s.funcs[funcName]
+	if !ok || f.decl.Body == nil {
+		return ""
+	}
+
+	var method string
+	ast.Inspect(f.decl.Body, func(n ast.Node) bool {
+		if method != "" {
+			return false
+		}
+
+		if call, ok := n.(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+				if assert, ok := sel.X.(*ast.TypeAssertExpr); ok {
+					if star, ok := assert.Type.(*ast.StarExpr); ok && isSelector(star.X, "conns", "AWSClient") {
+						if name := sel.Sel.Name; strings.HasSuffix(name, "Client") || strings.HasSuffix(name, "Conn") {
+							method = name
+						}
+					}
+				}
+			}
+		}
+
+		return true
+	})
+
+	return method
+}
+
+func (s *packageSource) funcNames() []string {
+	names := make([]string, 0, len(s.funcs))
+	for name := range s.funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// candidateFuncNames returns the unexported and exported variants of `<prefix><resourceName><suffix>` for each suffix.
+func candidateFuncNames(prefix, resourceName string, suffixes ...string) []string {
+	var names []string
+
+	for _, suffix := range suffixes {
+		names = append(names, prefix+resourceName+suffix, strings.ToUpper(prefix[:1])+prefix[1:]+resourceName+suffix)
+	}
+
+	return names
+}
+
+// stateUpgraders returns the state upgraders from a `[]schema.StateUpgrader` composite literal.
+func stateUpgraders(expr ast.Expr) []sdkStateUpgrader {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+
+	var upgraders []sdkStateUpgrader
+	for _, elt := range lit.Elts {
+		lit, ok := elt.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+
+		var upgrader sdkStateUpgrader
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				continue
+			}
+
+			switch key.Name {
+			case "Version":
+				if v, ok := kv.Value.(*ast.BasicLit); ok && v.Kind == token.INT {
+					upgrader.Version, _ = strconv.Atoi(v.Value)
+				}
+			case "Upgrade":
+				upgrader.FuncName = identName(kv.Value)
+			}
+		}
+
+		upgraders = append(upgraders, upgrader)
+	}
+
+	sort.Slice(upgraders, func(i, j int) bool {
+		return upgraders[i].Version < upgraders[j].Version
+	})
+
+	return upgraders
+}
+
+// fileImport returns the import spec, e.g. `awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"`,
+// of the package imported by file under the specified name.
+func fileImport(file *ast.File, name string) string {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		if spec.Name != nil {
+			if spec.Name.Name == name {
+				return spec.Name.Name + " " + spec.Path.Value
+			}
+		} else if path[strings.LastIndex(path, "/")+1:] == name {
+			return spec.Path.Value
+		}
+	}
+
+	return ""
+}
+
+func identName(expr ast.Expr) string {
+	if v, ok := expr.(*ast.Ident); ok {
+		return v.Name
+	}
+
+	return ""
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+
+	return ok && x.Name == pkg && sel.Sel.Name == name
+}
+
+func paramCount(decl *ast.FuncDecl) int {
+	return fieldCount(decl.Type.Params)
+}
+
+func resultCount(decl *ast.FuncDecl) int {
+	return fieldCount(decl.Type.Results)
+}
+
+func fieldCount(fields *ast.FieldList) int {
+	if fields == nil {
+		return 0
+	}
+
+	n := 0
+	for _, field := range fields.List {
+		if len(field.Names) == 0 {
+			n++
+		} else {
+			n += len(field.Names)
+		}
+	}
+
+	return n
+}
+
+// isStringParam returns whether the function's i'th parameter is of type string.
+func isStringParam(decl *ast.FuncDecl, i int) bool {
+	n := 0
+	for _, field := range decl.Type.Params.List {
+		names := len(field.Names)
+		if names == 0 {
+			names = 1
+		}
+
+		if i < n+names {
+			return identName(field.Type) == "string"
+		}
+
+		n += names
+	}
+
+	return false
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testExampleSource = `package example
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/example"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKResource("aws_example_widget", name="Widget")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/example/types;awstypes.Widget")
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+		ReadWithoutTimeout:   resourceWidgetRead,
+		UpdateWithoutTimeout: resourceWidgetUpdate,
+		DeleteWithoutTimeout: resourceWidgetDelete,
+
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceWidgetV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: widgetStateUpgradeV1,
+				Version: 1,
+			},
+			{
+				Type:    resourceWidgetV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: widgetStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	tags := &awstypes.Tag{}
+	input := &example.CreateWidgetInput{
+		Tags: []awstypes.Tag{*tags},
+	}
+
+	_, err := conn.CreateWidget(ctx, input)
+
+	return diag.FromErr(err)
+}
+
+func resourceWidgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceWidgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	if d.HasChange("tags") {
+		input := &example.DescribeWidgetInput{}
+		_ = input
+	}
+
+	input := &example.UpdateWidgetInput{}
+
+	_, err := conn.UpdateWidget(ctx, input)
+
+	return diag.FromErr(err)
+}
+
+func resourceWidgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func findWidgetByID(ctx context.Context, conn *example.Client, id string) (*awstypes.Widget, error) {
+	return nil, nil
+}
+
+func findWidget(ctx context.Context, conn *example.Client, input *example.DescribeWidgetInput) (*awstypes.Widget, error) {
+	return nil, nil
+}
+
+func waitWidgetAvailable(ctx context.Context, conn *example.Client, id string, timeout time.Duration) (*awstypes.Widget, error) {
+	return nil, nil
+}
+
+func waitWidgetDeleted(ctx context.Context, conn *example.Client, id string) (*awstypes.Widget, error) {
+	return nil, nil
+}
+`
+
+func testPackageSource(t *testing.T) *packageSource {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "widget.go"), []byte(testExampleSource), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The file being generated and test files are ignored.
+	if err := os.WriteFile(filepath.Join(dir, "widget_fw.go"), []byte("package example\n\nfunc findWidgetByARN() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "widget_test.go"), []byte("package example\n\nfunc waitWidgetUpdated() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := loadPackageSource(dir, filepath.Join(dir, "widget_fw.go"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func TestSDKResource(t *testing.T) {
+	t.Parallel()
+
+	s := testPackageSource(t)
+
+	got, err := s.sdkResource("aws_example_widget")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &sdkResource{
+		Annotations: []string{
+			`@Tags(identifierAttribute="arn")`,
+			`@Testing(existsType="github.com/aws/aws-sdk-go-v2/service/example/types;awstypes.Widget")`,
+		},
+		ConnMethod: "ExampleClient",
+		FuncName:   "resourceWidget",
+		HumanName:  "Widget",
+		Name:       "Widget",
+		Create:     "resourceWidgetCreate",
+		Read:       "resourceWidgetRead",
+		Update:     "resourceWidgetUpdate",
+		Delete:     "resourceWidgetDelete",
+		StateUpgraders: []sdkStateUpgrader{
+			{Version: 0, FuncName: "widgetStateUpgradeV0"},
+			{Version: 1, FuncName: "widgetStateUpgradeV1"},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := s.sdkResource("aws_example_gadget"); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestAPIOperation(t *testing.T) {
+	t.Parallel()
+
+	s := testPackageSource(t)
+
+	testCases := []struct {
+		funcName string
+		want     *apiOperation
+	}{
+		{
+			funcName: "resourceWidgetCreate",
+			want: &apiOperation{
+				Import:    `"github.com/aws/aws-sdk-go-v2/service/example"`,
+				InputType: "example.CreateWidgetInput",
+				Method:    "CreateWidget",
+				name:      "CreateWidget",
+			},
+		},
+		{
+			funcName: "resourceWidgetUpdate",
+			want: &apiOperation{
+				Import:    `"github.com/aws/aws-sdk-go-v2/service/example"`,
+				InputType: "example.UpdateWidgetInput",
+				Method:    "UpdateWidget",
+				name:      "UpdateWidget",
+			},
+		},
+		{
+			funcName: "resourceWidgetDelete",
+		},
+		{
+			funcName: "resourceGadgetDelete",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.funcName, func(t *testing.T) {
+			t.Parallel()
+
+			got := s.apiOperation(testCase.funcName)
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(apiOperation{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFinderAndWaiters(t *testing.T) {
+	t.Parallel()
+
+	s := testPackageSource(t)
+
+	if got, want := s.finder("Widget"), "findWidgetByID"; got != want {
+		t.Errorf("finder = %q, want %q", got, want)
+	}
+	if got, want := s.finder("Gadget"), ""; got != want {
+		t.Errorf("finder = %q, want %q", got, want)
+	}
+	if got, want := s.waiter("Widget", "Created", "Available", "Ready"), "waitWidgetAvailable"; got != want {
+		t.Errorf("create waiter = %q, want %q", got, want)
+	}
+	if got, want := s.waiter("Widget", "Updated"), ""; got != want {
+		t.Errorf("update waiter = %q, want %q", got, want)
+	}
+	// waitWidgetDeleted has no timeout parameter.
+	if got, want := s.waiter("Widget", "Deleted"), ""; got != want {
+		t.Errorf("delete waiter = %q, want %q", got, want)
+	}
+}